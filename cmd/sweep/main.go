@@ -0,0 +1,59 @@
+// Command sweep precomputes the equity of every game for every 4-card
+// starting hand class and writes the table read by the selector's -table
+// flag.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/whywaita/poker-penalty-paradise-pickem-selector/pkg/poker"
+)
+
+func main() {
+	out := flag.String("o", "equities.csv", "file to write the table to")
+	iters := flag.Int("iters", 2000, "deals per hand class and game")
+	players := flag.Int("players", 2, "number of players at the table, hero included (2-10)")
+	seed := flag.Int64("seed", 1, "random seed")
+	workers := flag.Int("workers", 0, "simulation goroutines (0 = one per CPU)")
+	flag.Parse()
+	if *players < 2 || *players > 10 {
+		fmt.Println("Error: -players must be between 2 and 10")
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	sim := poker.Simulator{Workers: *workers, Seed: *seed}
+
+	start := time.Now()
+	table, err := poker.BuildEquityTable(ctx, sim, *iters, *players, func(done, total int) {
+		if done%100 == 0 || done == total {
+			fmt.Fprintf(os.Stderr, "\r%d/%d hand classes (%v)", done, total, time.Since(start).Round(time.Second))
+		}
+	})
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		fmt.Println("Error:", err)
+		os.Exit(1)
+	}
+
+	f, err := os.Create(*out)
+	if err != nil {
+		fmt.Println("Error:", err)
+		os.Exit(1)
+	}
+	if err := table.Write(f); err != nil {
+		fmt.Println("Error:", err)
+		os.Exit(1)
+	}
+	if err := f.Close(); err != nil {
+		fmt.Println("Error:", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Wrote %d hand classes to %s\n", len(table.Equities), *out)
+}
@@ -1,34 +1,229 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"os"
+	"os/signal"
+	"strings"
 	"time"
 
 	"github.com/whywaita/poker-penalty-paradise-pickem-selector/pkg/poker"
 )
 
 func main() {
-	if len(os.Args) < 2 {
-		fmt.Printf("Usage: %s \"Ac Kd 2h 3c\"\n", os.Args[0])
+	players := flag.Int("players", 2, "number of players at the table, hero included (2-10, games that can't seat them are skipped)")
+	exact := flag.Bool("exact", false, "enumerate every deal where tractable instead of sampling (heads-up only)")
+	adaptive := flag.Bool("adaptive", false, "sample until the best game is separated from the rest with -confidence")
+	confidence := flag.Float64("confidence", 0.95, "confidence required by -adaptive")
+	budget := flag.Duration("budget", 0, "time budget for -adaptive (0 = until separated or the iteration cap)")
+	seed := flag.Int64("seed", 0, "random seed; the same seed replays the same deals (0 = pick one)")
+	workers := flag.Int("workers", 0, "simulation goroutines (0 = one per CPU)")
+	tablePath := flag.String("table", "", "answer from an equity table written by cmd/sweep, simulating live if it is missing or stale")
+	riskAverse := flag.Bool("risk-averse", false, "prefer games that lock up part of the pot over raw equity")
+	gameList := flag.String("games", "", "comma-separated games or aliases to choose from (default all)")
+	deadList := flag.String("dead", "", "cards known to be out of play, e.g. exposed or burned cards (\"7h 2s\")")
+	var rangeSpecs []string
+	flag.Func("range", "opponents' range in one game as game=range, e.g. \"badugi=badugi 9 or better\" or \"dbo=top 30%\" (repeatable)", func(s string) error {
+		rangeSpecs = append(rangeSpecs, s)
+		return nil
+	})
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] \"Ac Kd 2h 3c\"\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	if *players < 2 || *players > 10 {
+		fmt.Println("Error: -players must be between 2 and 10")
+		os.Exit(1)
+	}
+	if *exact && *players != 2 {
+		fmt.Println("Error: -exact is only supported heads-up")
+		os.Exit(1)
+	}
+	if *exact && *adaptive {
+		fmt.Println("Error: -exact and -adaptive can't be combined")
+		os.Exit(1)
+	}
+	if (len(rangeSpecs) > 0 || *deadList != "") && *exact {
+		fmt.Println("Error: -range and -dead can't be combined with -exact")
+		os.Exit(1)
+	}
+	if *tablePath != "" && (*exact || *adaptive) {
+		fmt.Println("Error: -table can't be combined with -exact or -adaptive")
 		os.Exit(1)
 	}
-	hand, err := poker.ParseHand(os.Args[1])
+	if *confidence <= 0 || *confidence >= 1 {
+		fmt.Println("Error: -confidence must be between 0 and 1")
+		os.Exit(1)
+	}
+	hand, err := poker.ParseHand(flag.Arg(0))
 	if err != nil {
 		fmt.Println("Error:", err)
 		os.Exit(1)
 	}
+	dead, err := poker.ParseCards(*deadList)
+	if err != nil {
+		fmt.Println("Error: -dead:", err)
+		os.Exit(1)
+	}
+	if overlap := poker.ToSet(dead).Intersect(poker.ToSet(hand)); overlap != 0 {
+		fmt.Printf("Error: -dead cards %s are in your hand\n", overlap)
+		os.Exit(1)
+	}
+	var games []poker.Game
+	if *gameList != "" {
+		if games, err = poker.ParseGames(*gameList); err != nil {
+			fmt.Println("Error:", err)
+			os.Exit(1)
+		}
+	}
+	risk := poker.RiskNeutral
+	if *riskAverse {
+		risk = poker.RiskAverse
+	}
+	// Ctrl-C cancels the simulation instead of killing the process mid-run
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+	sim := poker.Simulator{Workers: *workers, Seed: *seed, Ranges: map[string]poker.Range{}, Dead: poker.ToSet(dead)}
+	for _, spec := range rangeSpecs {
+		name, rangeSpec, ok := strings.Cut(spec, "=")
+		info, found := poker.LookupGame(strings.TrimSpace(name))
+		if !ok || !found {
+			fmt.Printf("Error: -range %q must be game=range with a known game\n", spec)
+			os.Exit(1)
+		}
+		r, err := poker.ParseRange(info.Game, rangeSpec)
+		if err != nil {
+			fmt.Printf("Error: -range %q: %v\n", spec, err)
+			os.Exit(1)
+		}
+		sim.Ranges[info.Name()] = r
+	}
+
+	var table *poker.EquityTable
+	if *tablePath != "" {
+		table, err = poker.LoadEquityTable(*tablePath)
+		switch {
+		case err != nil:
+			fmt.Printf("Equity table unusable, simulating instead: %v\n", err)
+			table = nil
+		case table.Players != *players:
+			fmt.Printf("Equity table is for %d players, simulating instead\n", table.Players)
+			table = nil
+		case *riskAverse:
+			fmt.Println("Equity table has no variances for -risk-averse, simulating instead")
+			table = nil
+		case len(rangeSpecs) > 0:
+			fmt.Println("Equity table assumes random opponents, simulating instead for -range")
+			table = nil
+		case len(dead) > 0:
+			fmt.Println("Equity table assumes a full deck, simulating instead for -dead")
+			table = nil
+		case !table.Has(games...):
+			fmt.Println("Equity table lacks some of -games, simulating instead")
+			table = nil
+		}
+	}
+
 	start := time.Now()
-	best, eqs := poker.PickBestGame(hand, 100000) // 100k sims per game for better accuracy
+	var sel poker.Selection
+	if table != nil {
+		sel, err = table.LookupBestGame(hand, games...)
+	} else if *adaptive {
+		sel, err = sim.PickBestGameAdaptive(ctx, hand, poker.AdaptiveOptions{
+			Players:    *players,
+			Risk:       risk,
+			Confidence: *confidence,
+			TimeBudget: *budget,
+			Games:      games,
+		})
+	} else if *exact {
+		sel, err = sim.PickBestGameExact(ctx, hand, risk, games...)
+	} else {
+		sel, err = sim.PickBestGame(ctx, hand, poker.DefaultIterations, *players, risk, games...)
+	}
+	if err != nil {
+		fmt.Println("Error:", err)
+		os.Exit(1)
+	}
+	if sel.Best == nil {
+		if len(dead) > 0 {
+			fmt.Printf("Error: none of the games is implemented and can be dealt to %d players without the %d dead cards\n", *players, len(dead))
+		} else {
+			fmt.Printf("Error: none of the games is implemented and can be played by %d players\n", *players)
+		}
+		os.Exit(1)
+	}
 	dur := time.Since(start)
 
 	fmt.Printf("Hand: %s %s %s %s\n", hand[0], hand[1], hand[2], hand[3])
+	class := poker.HandClassIndex(hand)
+	canon := poker.HandClasses()[class].Cards
+	fmt.Printf("Class: #%d (%s %s %s %s up to suits)\n", class, canon[0], canon[1], canon[2], canon[3])
 	fmt.Println("--------------------------------------------------")
-	fmt.Println("Estimated equities vs 1 random opponent:")
-	for g, e := range eqs {
-		fmt.Printf("%-20s %.3f\n", g, e)
+	kind := "random"
+	if len(rangeSpecs) > 0 {
+		kind = "ranged"
+	}
+	if *players == 2 {
+		fmt.Printf("Estimated equities vs 1 %s opponent:\n", kind)
+	} else {
+		fmt.Printf("Estimated equities vs %d %s opponents:\n", *players-1, kind)
+	}
+	for _, spec := range rangeSpecs {
+		fmt.Printf("  range %s\n", spec)
+	}
+	if len(dead) > 0 {
+		fmt.Printf("  dead cards %s\n", poker.ToSet(dead))
+	}
+	if table == nil {
+		fmt.Printf("%-20s %6s %7s %5s %5s %5s %6s %6s %8s %10s\n",
+			"", "equity", "±95%", "win", "tie", "loss", "scoop", "split", "deals", "time")
+	}
+	for _, r := range sel.Results {
+		switch {
+		case errors.Is(r.Err, poker.ErrNotImplemented):
+			fmt.Printf("%-20s not implemented, skipped\n", r.Name())
+		case errors.Is(r.Err, poker.ErrTableSize):
+			fmt.Printf("%-20s not played by %d players, skipped\n", r.Name(), *players)
+		case errors.Is(r.Err, poker.ErrShortDeck):
+			fmt.Printf("%-20s too few cards left after the dead cards, skipped\n", r.Name())
+		case r.Err != nil:
+			fmt.Printf("%-20s %v, skipped\n", r.Name(), r.Err)
+		case table != nil:
+			fmt.Printf("%-20s %.3f\n", r.Name(), r.Equity)
+		default:
+			fmt.Printf("%-20s %6.3f %7.3f %4.0f%% %4.0f%% %4.0f%% %5.0f%% %5.0f%% %8d %10v\n",
+				r.Name(), r.Equity, 1.96*r.StdErr, 100*r.Win, 100*r.Tie, 100*r.Loss,
+				100*r.Scoop, 100*r.Split, r.Iterations, r.Duration.Round(time.Millisecond))
+		}
 	}
 	fmt.Println("--------------------------------------------------")
-	fmt.Printf("=> Best game to register: %s\n", best.Name())
+	fmt.Printf("=> Best game to register: %s\n", sel.Best.Name())
+	if len(sel.Results) > 1 && sel.Results[1].Err == nil {
+		fmt.Printf("Margin over %s: %.3f\n", sel.Results[1].Name(), sel.Margin)
+	}
+	if table != nil {
+		fmt.Printf("From equity table %s (%d deals per game)\n", *tablePath, table.Iterations)
+		return
+	}
 	fmt.Printf("Simulation time: %v\n", dur)
+	// -exact samples the games it can't enumerate, and those depend on the seed
+	sampled := !*exact
+	for _, r := range sel.Results {
+		sampled = sampled || r.Err == nil && !r.Exact
+	}
+	if sampled {
+		fmt.Printf("Seed: %d (rerun with -seed %d to reproduce)\n", *seed, *seed)
+	}
 }
@@ -0,0 +1,121 @@
+package poker
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// AdaptiveOptions controls PickBestGameAdaptive. Zero fields take the
+// defaults noted on each field.
+type AdaptiveOptions struct {
+	// Players at the table, hero included (default 2)
+	Players int
+	// Risk ranks the games (default RiskNeutral)
+	Risk RiskPreference
+	// Confidence required that the best game beats every other game (default 0.95)
+	Confidence float64
+	// BatchSize is the number of deals added to each contending game per round (default 1000)
+	BatchSize int
+	// MaxIterations caps the deals simulated for any one game (default DefaultIterations)
+	MaxIterations int
+	// TimeBudget stops sampling once spent (default no limit)
+	TimeBudget time.Duration
+	// Games to choose from (default every implemented registered game)
+	Games []Game
+}
+
+// PickBestGameAdaptive samples every game in batches until the best game is
+// separated from all others with the requested confidence, the time budget
+// runs out or MaxIterations is reached. A game is dropped as soon as it is
+// confidently behind the leader, so clear-cut hands finish after one batch
+// and the remaining time goes to games that are still close. Separation is
+// judged on the risk score using each game's equity standard error.
+// Games that aren't implemented are skipped and listed with
+// ErrNotImplemented.
+func PickBestGameAdaptive(my4 []Card, opts AdaptiveOptions) Selection {
+	sel, _ := Simulator{}.PickBestGameAdaptive(context.Background(), my4, opts)
+	return sel
+}
+
+// PickBestGameAdaptive is the package-level PickBestGameAdaptive on this
+// simulator's worker pool. If ctx is cancelled it returns the standings so
+// far along with ctx.Err().
+func (s Simulator) PickBestGameAdaptive(ctx context.Context, my4 []Card, opts AdaptiveOptions) (Selection, error) {
+	if opts.Players == 0 {
+		opts.Players = 2
+	}
+	if opts.Confidence == 0 {
+		opts.Confidence = 0.95
+	}
+	if opts.BatchSize == 0 {
+		opts.BatchSize = 1000
+	}
+	if opts.MaxIterations == 0 {
+		opts.MaxIterations = DefaultIterations
+	}
+	// One-sided: we only need the leader to be better, not different
+	z := math.Sqrt2 * math.Erfinv(2*opts.Confidence-1)
+	start := time.Now()
+
+	games := candidateGames(opts.Games, opts.Players, s.Dead)
+	stats := make([]shareStats, len(games))
+	results := make([]GameResult, len(games))
+	contending := make([]bool, len(games))
+	for i, g := range games {
+		results[i].Game = g
+		contending[i] = true
+	}
+	selection := func() Selection {
+		return newSelection(appendSkipped(results, opts.Games, opts.Players, s.Dead))
+	}
+	if len(games) == 0 {
+		return selection(), nil
+	}
+	for round := 0; ; round++ {
+		sampled := false
+		var err error
+		for i, g := range games {
+			if !contending[i] || stats[i].n >= opts.MaxIterations {
+				continue
+			}
+			batch := min(opts.BatchSize, opts.MaxIterations-stats[i].n)
+			batchStart := time.Now()
+			err = s.simulateInto(ctx, &stats[i], round, g, my4, batch, opts.Players)
+			res := stats[i].result()
+			results[i].EquityResult = res
+			results[i].Score = opts.Risk.Score(res)
+			results[i].Duration += time.Since(batchStart)
+			sampled = true
+			if err != nil {
+				break
+			}
+		}
+
+		leader := -1
+		for i, r := range results {
+			if contending[i] && (leader == -1 || beats(r.Game, r.Score, results[leader].Game, results[leader].Score)) {
+				leader = i
+			}
+		}
+		lead := results[leader]
+		remaining := 0
+		for i, r := range results {
+			if !contending[i] || i == leader {
+				continue
+			}
+			if lead.Score-r.Score > z*math.Hypot(lead.StdErr, r.StdErr) {
+				contending[i] = false
+			} else {
+				remaining++
+			}
+		}
+
+		if remaining == 0 || !sampled || err != nil {
+			return selection(), err
+		}
+		if opts.TimeBudget > 0 && time.Since(start) >= opts.TimeBudget {
+			return selection(), nil
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package poker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEquityResultCI95(t *testing.T) {
+	res := EquityResult{Equity: 0.6, StdErr: 0.01}
+	low, high := res.CI95()
+	if low < 0.5803 || low > 0.5805 || high < 0.6195 || high > 0.6197 {
+		t.Errorf("CI95() = (%.4f, %.4f), want (0.5804, 0.6196)", low, high)
+	}
+
+	hand := []Card{mustCard("Ks"), mustCard("Qd"), mustCard("5h"), mustCard("2c")}
+	sampled := SimulateEquityResult(HiDuGiGame{}, hand, 4000, 2)
+	if sampled.StdErr <= 0 || sampled.StdErr > 0.02 {
+		t.Errorf("StdErr = %.4f, want a small positive error after 4000 deals", sampled.StdErr)
+	}
+	if exact := ExactEquity(HiDuGiGame{}, hand); exact.StdErr != 0 {
+		t.Errorf("exact StdErr = %.4f, want 0", exact.StdErr)
+	}
+}
+
+func TestPickBestGameAdaptive(t *testing.T) {
+	t.Run("Clear-cut hand stops after one batch", func(t *testing.T) {
+		hand := []Card{mustCard("2s"), mustCard("3d"), mustCard("4h"), mustCard("5c")}
+		sel := PickBestGameAdaptive(hand, AdaptiveOptions{BatchSize: 1000, MaxIterations: 20000})
+		if sel.Best.Name() != "Badugi" {
+			t.Errorf("best = %s, want Badugi", sel.Best.Name())
+		}
+		for _, res := range sel.Results {
+			if res.Err == nil && res.Iterations != 1000 {
+				t.Errorf("%s: %d deals, want a single batch of 1000", res.Name(), res.Iterations)
+			}
+		}
+	})
+
+	t.Run("Time budget stops sampling", func(t *testing.T) {
+		hand := []Card{mustCard("Ac"), mustCard("Kd"), mustCard("2h"), mustCard("3c")}
+		sel := PickBestGameAdaptive(hand, AdaptiveOptions{
+			BatchSize:     200,
+			Confidence:    0.999999,
+			MaxIterations: 1000000,
+			TimeBudget:    time.Nanosecond,
+		})
+		if len(sel.Results) != len(Games()) {
+			t.Fatalf("got %d results, want one per game", len(sel.Results))
+		}
+		for _, res := range sel.Results {
+			if res.Err == nil && res.Iterations != 200 {
+				t.Errorf("%s: %d deals, want a single batch of 200", res.Name(), res.Iterations)
+			}
+		}
+	})
+
+	t.Run("Iteration cap", func(t *testing.T) {
+		hand := []Card{mustCard("Ac"), mustCard("Kd"), mustCard("2h"), mustCard("3c")}
+		sel := PickBestGameAdaptive(hand, AdaptiveOptions{
+			BatchSize:     300,
+			Confidence:    0.999999,
+			MaxIterations: 500,
+		})
+		for _, res := range sel.Results {
+			if res.Iterations > 500 {
+				t.Errorf("%s: %d deals, want at most 500", res.Name(), res.Iterations)
+			}
+		}
+	})
+}
@@ -0,0 +1,104 @@
+package poker
+
+import (
+	"slices"
+	"sync"
+)
+
+// suitPerms lists the 24 permutations of the four suits
+var suitPerms = func() (perms [24][4]int) {
+	n := 0
+	var rec func(perm [4]int, used, depth int)
+	rec = func(perm [4]int, used, depth int) {
+		if depth == 4 {
+			perms[n] = perm
+			n++
+			return
+		}
+		for s := 0; s < 4; s++ {
+			if used&(1<<s) == 0 {
+				perm[depth] = s
+				rec(perm, used|1<<s, depth+1)
+			}
+		}
+	}
+	rec([4]int{}, 0, 0)
+	return
+}()
+
+// relabel moves every card of suit s in set to suit perm[s]
+func relabel(set CardSet, perm [4]int) CardSet {
+	var out CardSet
+	for s, to := range perm {
+		out |= (set >> s & suitBits) << to
+	}
+	return out
+}
+
+// canonicalSet returns the suit relabelling of set with the largest
+// bitmask, which is the same for every hand that differs only by suits
+func canonicalSet(set CardSet) CardSet {
+	best := set
+	for _, perm := range suitPerms {
+		best = max(best, relabel(set, perm))
+	}
+	return best
+}
+
+// Canonicalize returns the representative of the hand's suit-isomorphism
+// class: every hand that is the same up to renaming suits gets the same
+// cards back. Suits are assigned from the top card down, spades first, so
+// Ac Kd 2h 3c becomes As Kh 3s 2d. The cards are ordered highest first.
+func Canonicalize(cards []Card) []Card {
+	out := canonicalSet(NewCardSet(cards...)).Cards()
+	slices.Reverse(out)
+	return out
+}
+
+// HandClass is one class of 4-card starting hands that are the same up to
+// renaming suits
+type HandClass struct {
+	// Cards is the canonical hand of the class, as returned by Canonicalize
+	Cards []Card
+	// Combos is the number of 4-card hands in the class
+	Combos int
+}
+
+var (
+	handClassesOnce sync.Once
+	handClasses     []HandClass
+	handClassSets   []CardSet // canonical set of each class, ascending
+)
+
+// HandClasses returns every class of 4-card starting hands. Indexes into
+// the slice are the class indexes returned by HandClassIndex and are
+// stable: classes are ordered by their canonical bitmask.
+func HandClasses() []HandClass {
+	handClassesOnce.Do(func() {
+		combos := make(map[CardSet]int)
+		ForEachCombination(FullDeck(), 4, func(hand []Card) {
+			combos[canonicalSet(NewCardSet(hand...))]++
+		})
+		for set := range combos {
+			handClassSets = append(handClassSets, set)
+		}
+		slices.Sort(handClassSets)
+		handClasses = make([]HandClass, len(handClassSets))
+		for i, set := range handClassSets {
+			cards := set.Cards()
+			slices.Reverse(cards)
+			handClasses[i] = HandClass{Cards: cards, Combos: combos[set]}
+		}
+	})
+	return handClasses
+}
+
+// HandClassIndex returns the index in HandClasses of a 4-card hand's class
+func HandClassIndex(my4 []Card) int {
+	if len(my4) != 4 {
+		panic("HandClassIndex expects 4 cards")
+	}
+	HandClasses()
+	i, _ := slices.BinarySearch(handClassSets, canonicalSet(NewCardSet(my4...)))
+	return i
+}
@@ -0,0 +1,64 @@
+package poker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCanonicalize(t *testing.T) {
+	tests := []struct {
+		name string
+		hand string
+		want string
+	}{
+		{"Rainbow", "Ac Kd 2h 3s", "As Kh 3d 2c"},
+		{"Suited ace", "Ac Kd 2h 3c", "As Kh 3s 2d"},
+		{"Suits renamed", "Ah Ks 2d 3h", "As Kh 3s 2d"},
+		{"Order doesn't matter", "3c 2h Kd Ac", "As Kh 3s 2d"},
+		{"Double suited", "Ah Kh 2c 3c", "As Ks 3h 2h"},
+		{"Pair of aces", "Ad Ac Kd 7h", "As Ah Ks 7d"},
+		{"Four of a suit", "2d 5d 9d Jd", "Js 9s 5s 2s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hand, err := ParseHand(tt.hand)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, _ := ParseHand(tt.want)
+			if got := Canonicalize(hand); !reflect.DeepEqual(got, want) {
+				t.Errorf("Canonicalize(%s) = %v, want %v", tt.hand, got, want)
+			}
+		})
+	}
+}
+
+func TestHandClasses(t *testing.T) {
+	classes := HandClasses()
+	if len(classes) != 16432 {
+		t.Errorf("%d classes of 4-card hands, want 16432", len(classes))
+	}
+	total := 0
+	for i, c := range classes {
+		total += c.Combos
+		if got := HandClassIndex(c.Cards); got != i {
+			t.Fatalf("HandClassIndex(%v) = %d, want %d", c.Cards, got, i)
+		}
+		if !reflect.DeepEqual(Canonicalize(c.Cards), c.Cards) {
+			t.Fatalf("class %d cards %v are not canonical", i, c.Cards)
+		}
+	}
+	if total != 270725 {
+		t.Errorf("classes cover %d hands, want C(52,4) = 270725", total)
+	}
+
+	a, _ := ParseHand("Ac Kd 2h 3c")
+	b, _ := ParseHand("Ad Kc 2s 3d")
+	if HandClassIndex(a) != HandClassIndex(b) {
+		t.Errorf("%v and %v should share a class", a, b)
+	}
+	if c := classes[HandClassIndex(a)]; c.Combos != 24 {
+		t.Errorf("%v class has %d hands, want 24", a, c.Combos)
+	}
+}
@@ -0,0 +1,92 @@
+package poker
+
+import (
+	"iter"
+	"math/bits"
+)
+
+// CardSet is a set of cards as a bitmask: bit c is set when card c is in
+// the set. Because a card is rank*4+suit, the four cards of a rank share one
+// nibble, ranks ascend from the low bits and each suit is every fourth bit.
+type CardSet uint64
+
+// AllCards is the set of all 52 cards
+const AllCards CardSet = 1<<52 - 1
+
+// suitBits has the bit of the deuce of clubs and of every club above it;
+// shifting by the suit gives that suit's cards
+const suitBits CardSet = 0x1111111111111
+
+// NewCardSet returns the set of cards
+func NewCardSet(cards ...Card) CardSet {
+	var s CardSet
+	for _, c := range cards {
+		s |= 1 << c
+	}
+	return s
+}
+
+// Add returns s with c added
+func (s CardSet) Add(c Card) CardSet { return s | 1<<c }
+
+// Remove returns s without c
+func (s CardSet) Remove(c Card) CardSet { return s &^ (1 << c) }
+
+// Contains reports whether c is in s
+func (s CardSet) Contains(c Card) bool { return s&(1<<c) != 0 }
+
+// Union returns the cards in either set
+func (s CardSet) Union(o CardSet) CardSet { return s | o }
+
+// Intersect returns the cards in both sets
+func (s CardSet) Intersect(o CardSet) CardSet { return s & o }
+
+// Minus returns the cards of s that are not in o
+func (s CardSet) Minus(o CardSet) CardSet { return s &^ o }
+
+// Count returns the number of cards in s
+func (s CardSet) Count() int { return bits.OnesCount64(uint64(s)) }
+
+// RankCount returns how many cards of rank r are in s
+func (s CardSet) RankCount(r int) int { return bits.OnesCount64(uint64(s>>(4*r)) & 0xF) }
+
+// SuitCount returns how many cards of suit su are in s
+func (s CardSet) SuitCount(su int) int { return bits.OnesCount64(uint64(s & (suitBits << su))) }
+
+// All iterates over the cards of s in ascending order
+func (s CardSet) All() iter.Seq[Card] {
+	return func(yield func(Card) bool) {
+		for s != 0 {
+			c := Card(bits.TrailingZeros64(uint64(s)))
+			if !yield(c) {
+				return
+			}
+			s &= s - 1
+		}
+	}
+}
+
+// AppendCards appends the cards of s in ascending order to dst
+func (s CardSet) AppendCards(dst []Card) []Card {
+	for ; s != 0; s &= s - 1 {
+		dst = append(dst, Card(bits.TrailingZeros64(uint64(s))))
+	}
+	return dst
+}
+
+// Cards returns the cards of s in ascending order
+func (s CardSet) Cards() []Card {
+	return s.AppendCards(make([]Card, 0, s.Count()))
+}
+
+// String lists the cards of s, e.g. "2c Ah"
+func (s CardSet) String() string {
+	out := ""
+	for c := range s.All() {
+		if out != "" {
+			out += " "
+		}
+		out += c.String()
+	}
+	return out
+}
@@ -0,0 +1,61 @@
+package poker
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestCardSet(t *testing.T) {
+	a := NewCardSet(mustCard("As"), mustCard("2c"), mustCard("Kh"))
+	b := NewCardSet(mustCard("Kh"), mustCard("Kd"))
+
+	tests := []struct {
+		name string
+		set  CardSet
+		want []Card
+	}{
+		{"Cards ascend", a, []Card{mustCard("2c"), mustCard("Kh"), mustCard("As")}},
+		{"Union", a.Union(b), []Card{mustCard("2c"), mustCard("Kd"), mustCard("Kh"), mustCard("As")}},
+		{"Intersect", a.Intersect(b), []Card{mustCard("Kh")}},
+		{"Minus", a.Minus(b), []Card{mustCard("2c"), mustCard("As")}},
+		{"Add", b.Add(mustCard("Ks")), []Card{mustCard("Kd"), mustCard("Kh"), mustCard("Ks")}},
+		{"Remove", b.Remove(mustCard("Kd")), []Card{mustCard("Kh")}},
+		{"Empty", CardSet(0), []Card{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.set.Cards(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Cards() = %v, want %v", got, tt.want)
+			}
+			if got := slices.Collect(tt.set.All()); !slices.Equal(got, tt.want) {
+				t.Errorf("All() = %v, want %v", got, tt.want)
+			}
+			if got := tt.set.Count(); got != len(tt.want) {
+				t.Errorf("Count() = %d, want %d", got, len(tt.want))
+			}
+			for _, c := range tt.want {
+				if !tt.set.Contains(c) {
+					t.Errorf("Contains(%s) = false", c)
+				}
+			}
+		})
+	}
+
+	if n := AllCards.Count(); n != 52 {
+		t.Errorf("AllCards has %d cards, want 52", n)
+	}
+	if n := a.Union(b).RankCount(11); n != 2 {
+		t.Errorf("RankCount(K) = %d, want 2", n)
+	}
+	if n := a.Union(b).SuitCount(2); n != 1 {
+		t.Errorf("SuitCount(h) = %d, want 1", n)
+	}
+	if n := AllCards.SuitCount(3); n != 13 {
+		t.Errorf("AllCards.SuitCount(s) = %d, want 13", n)
+	}
+	if got := a.String(); got != "2c Kh As" {
+		t.Errorf("String() = %q, want %q", got, "2c Kh As")
+	}
+}
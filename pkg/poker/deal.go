@@ -0,0 +1,214 @@
+package poker
+
+import (
+	"errors"
+	mrand "math/rand"
+	"sort"
+)
+
+// ErrEmptyRange is reported when an opponent's range holds no hand that the
+// cards left in the deck can make
+var ErrEmptyRange = errors.New("no hand left in the deck is in the opponents' range")
+
+// Deal is the state of one hand being dealt. Games fill it in place from
+// CompleteHand; the simulator keeps one Deal per worker and resets it for
+// every deal, so dealing a hand allocates nothing.
+type Deal struct {
+	// Hands holds every player's hand, the hero's first. Hands dealt from
+	// the deck share its storage and are only valid until the next Reset.
+	Hands [][]Card
+	// Board holds the community cards, if any
+	Board []Card
+	// Deck holds the cards that are still undealt
+	Deck []Card
+	// Muck holds the cards discarded so far
+	Muck []Card
+	// Range, if set, is the opponents' range: DealOpponents redeals the
+	// hands they wouldn't play
+	Range Range
+	// Dead holds the cards known to be out of play, which draw strategies
+	// don't expect to draw
+	Dead CardSet
+
+	deck, spare, reshuffle, unseen []Card
+	err                            error
+	inRange                        rangeHands
+}
+
+// NewDeal returns a Deal for `players` players (hero included) dealing
+// from a copy of deck
+func NewDeal(deck []Card, players int) *Deal {
+	d := &Deal{
+		Hands:     make([][]Card, players),
+		Muck:      make([]Card, 0, 52),
+		deck:      make([]Card, 0, 52),
+		spare:     make([]Card, 0, 16),
+		reshuffle: make([]Card, 0, 52),
+		unseen:    make([]Card, 0, 52),
+	}
+	d.Reset(deck)
+	return d
+}
+
+// Reset starts a new hand from a copy of deck, keeping the buffers
+func (d *Deal) Reset(deck []Card) {
+	d.deck = append(d.deck[:0], deck...)
+	d.Deck = d.deck
+	clear(d.Hands)
+	d.Board = nil
+	d.Muck = d.Muck[:0]
+	d.spare = d.spare[:0]
+	d.err = nil
+}
+
+// Err returns ErrEmptyRange if an opponent couldn't be dealt a hand in
+// Range since the last Reset. The rest of that deal is meaningless.
+func (d *Deal) Err() error { return d.err }
+
+// Opponents returns the number of opponents being dealt to
+func (d *Deal) Opponents() int { return len(d.Hands) - 1 }
+
+// Take deals n random cards from the deck
+func (d *Deal) Take(r *mrand.Rand, n int) []Card {
+	var cards []Card
+	cards, d.Deck = DrawRandom(r, d.Deck, n)
+	return cards
+}
+
+// maxRangeTries bounds the redeals of one opponent hand before the hand is
+// chosen from a list of the hands in range instead
+const maxRangeTries = 1000
+
+// DealOpponents deals every opponent a hand of size random cards. With a
+// Range each hand is redealt until the opponent plays it, weighted hands
+// being played with their weight. Ranges too narrow for that to succeed
+// within maxRangeTries redeals are dealt from a weighted list of the hands
+// in range instead; if the deck can't make any of them, Err reports
+// ErrEmptyRange. Either way opponents only hold hands in their range.
+func (d *Deal) DealOpponents(r *mrand.Rand, size int) {
+	for i := 1; i < len(d.Hands) && d.err == nil; i++ {
+		if d.Range == nil {
+			d.Hands[i] = d.Take(r, size)
+			continue
+		}
+		d.Hands[i] = d.takeInRange(r, size)
+	}
+}
+
+// takeInRange deals one hand of size cards from Range
+func (d *Deal) takeInRange(r *mrand.Rand, size int) []Card {
+	// d.deck holds every card of the deal in some order. Once a range has
+	// needed the list, later deals use it straight away.
+	all := ToSet(d.deck)
+	for try := 0; try < maxRangeTries && !d.inRange.builtFor(all, size); try++ {
+		// A folded hand stays in the deck; DrawRandom only reorders it
+		hand, rest := DrawRandom(r, d.Deck, size)
+		if w := d.Range.Weight(hand); w >= 1 || w > 0 && r.Float64() < w {
+			d.Deck = rest
+			return hand
+		}
+	}
+	if !d.inRange.builtFor(all, size) {
+		d.inRange.build(d.Range, d.deck, size)
+	}
+	pick, ok := d.inRange.pick(r, ToSet(d.Deck))
+	if !ok {
+		d.err = ErrEmptyRange
+		return nil
+	}
+	n := 0
+	for i, c := range d.Deck {
+		if pick.Contains(c) {
+			d.Deck[n], d.Deck[i] = d.Deck[i], d.Deck[n]
+			n++
+		}
+	}
+	hand := d.Deck[:size]
+	d.Deck = d.Deck[size:]
+	return hand
+}
+
+// rangeHands lists the hands of one size that a range plays from one deck,
+// with running totals of their weights. It is built the first time a deal
+// needs it and used while the deck and hand size stay the same.
+type rangeHands struct {
+	deck  CardSet
+	size  int
+	hands []CardSet
+	cum   []float64
+}
+
+// builtFor reports whether the list was built for size-card hands from deck
+func (l *rangeHands) builtFor(deck CardSet, size int) bool {
+	return l.hands != nil && l.deck == deck && l.size == size
+}
+
+func (l *rangeHands) build(rg Range, deck []Card, size int) {
+	*l = rangeHands{deck: ToSet(deck), size: size, hands: []CardSet{}}
+	total := 0.0
+	ForEachCombination(deck, size, func(h []Card) {
+		if w := rg.Weight(h); w > 0 {
+			total += w
+			l.hands = append(l.hands, ToSet(h))
+			l.cum = append(l.cum, total)
+		}
+	})
+}
+
+// pick draws a hand that live can make, with probability proportional to
+// its weight, and reports whether there is one
+func (l *rangeHands) pick(r *mrand.Rand, live CardSet) (CardSet, bool) {
+	if len(l.hands) == 0 {
+		return 0, false
+	}
+	total := l.cum[len(l.cum)-1]
+	// Most hands of the list are usually still live
+	for try := 0; try < maxRangeTries; try++ {
+		h := l.hands[sort.SearchFloat64s(l.cum, r.Float64()*total)]
+		if h.Minus(live) == 0 {
+			return h, true
+		}
+	}
+	total = 0
+	for i, h := range l.hands {
+		if h.Minus(live) == 0 {
+			total += l.weight(i)
+		}
+	}
+	target := r.Float64() * total
+	var last CardSet
+	for i, h := range l.hands {
+		if h.Minus(live) == 0 {
+			if last = h; target < l.weight(i) {
+				return h, true
+			}
+			target -= l.weight(i)
+		}
+	}
+	// Rounding can leave target just above the last live hand
+	return last, last != 0
+}
+
+// weight returns the weight of the i-th hand
+func (l *rangeHands) weight(i int) float64 {
+	if i == 0 {
+		return l.cum[0]
+	}
+	return l.cum[i] - l.cum[i-1]
+}
+
+// Hand returns a new hand holding cards followed by more, in storage owned
+// by the Deal, for hands that aren't simply dealt from the deck
+func (d *Deal) Hand(cards, more []Card) []Card {
+	start := len(d.spare)
+	d.spare = append(append(d.spare, cards...), more...)
+	return d.spare[start:len(d.spare):len(d.spare)]
+}
+
+// shuffleInMuck returns the muck to the deck when the deck runs short
+func (d *Deal) shuffleInMuck() {
+	// d.Deck may already live in d.reshuffle; append copies it down safely
+	d.reshuffle = append(append(d.reshuffle[:0], d.Deck...), d.Muck...)
+	d.Deck = d.reshuffle
+	d.Muck = d.Muck[:0]
+}
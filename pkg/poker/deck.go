@@ -1,20 +1,9 @@
 package poker
 
 import (
-	"crypto/rand"
-	"encoding/binary"
 	mrand "math/rand"
 )
 
-// rng is our random number generator
-var rng *mrand.Rand
-
-func init() {
-	var seed int64
-	binary.Read(rand.Reader, binary.LittleEndian, &seed)
-	rng = mrand.New(mrand.NewSource(seed))
-}
-
 // FullDeck returns a complete 52-card deck
 func FullDeck() []Card {
 	d := make([]Card, 52)
@@ -25,10 +14,10 @@ func FullDeck() []Card {
 }
 
 // RemoveCards returns deck with specified cards removed
-func RemoveCards(deck []Card, toRemove map[Card]struct{}) []Card {
+func RemoveCards(deck []Card, toRemove CardSet) []Card {
 	out := make([]Card, 0, len(deck))
 	for _, c := range deck {
-		if _, ok := toRemove[c]; !ok {
+		if !toRemove.Contains(c) {
 			out = append(out, c)
 		}
 	}
@@ -36,22 +25,49 @@ func RemoveCards(deck []Card, toRemove map[Card]struct{}) []Card {
 }
 
 // DrawRandom draws n unique random cards from `deck` in‑place (Fisher‑Yates shuffle prefix)
-func DrawRandom(deck []Card, n int) ([]Card, []Card) {
+func DrawRandom(r *mrand.Rand, deck []Card, n int) ([]Card, []Card) {
 	if n > len(deck) {
 		panic("DrawRandom: not enough cards")
 	}
 	for i := 0; i < n; i++ {
-		j := rng.Intn(len(deck)-i) + i
+		j := r.Intn(len(deck)-i) + i
 		deck[i], deck[j] = deck[j], deck[i]
 	}
 	return deck[:n], deck[n:]
 }
 
-// ToSet converts a slice of cards to a set
-func ToSet(cards []Card) map[Card]struct{} {
-	m := make(map[Card]struct{}, len(cards))
-	for _, c := range cards {
-		m[c] = struct{}{}
+// ForEachCombination calls fn with every k-card combination of deck, in
+// lexicographic order of positions. The slice passed to fn is reused.
+func ForEachCombination(deck []Card, k int, fn func([]Card)) {
+	if k > len(deck) {
+		return
 	}
-	return m
+	idx := make([]int, k)
+	for i := range idx {
+		idx[i] = i
+	}
+	combo := make([]Card, k)
+	for {
+		for i, j := range idx {
+			combo[i] = deck[j]
+		}
+		fn(combo)
+		// advance to the next combination
+		i := k - 1
+		for i >= 0 && idx[i] == len(deck)-k+i {
+			i--
+		}
+		if i < 0 {
+			return
+		}
+		idx[i]++
+		for j := i + 1; j < k; j++ {
+			idx[j] = idx[j-1] + 1
+		}
+	}
+}
+
+// ToSet converts a slice of cards to a set
+func ToSet(cards []Card) CardSet {
+	return NewCardSet(cards...)
 }
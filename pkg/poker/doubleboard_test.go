@@ -0,0 +1,33 @@
+package poker
+
+import (
+	"testing"
+)
+
+func TestEvaluateDoubleBoard(t *testing.T) {
+	board := []Card{
+		// First board: spade flush draw
+		mustCard("Qs"), mustCard("9s"), mustCard("4s"), mustCard("Jd"), mustCard("2c"),
+		// Second board: kings
+		mustCard("Kd"), mustCard("Kh"), mustCard("7c"), mustCard("6d"), mustCard("3h"),
+	}
+	flush := []Card{mustCard("As"), mustCard("8s"), mustCard("5h"), mustCard("5c")}
+	kings := []Card{mustCard("Ks"), mustCard("Kc"), mustCard("Td"), mustCard("8h")}
+
+	flushFirst, flushSecond := EvaluateDoubleBoard(flush, board)
+	kingsFirst, kingsSecond := EvaluateDoubleBoard(kings, board)
+	if flushFirst <= kingsFirst {
+		t.Errorf("flush should win the first board: %d vs %d", flushFirst, kingsFirst)
+	}
+	if kingsSecond <= flushSecond {
+		t.Errorf("quad kings should win the second board: %d vs %d", kingsSecond, flushSecond)
+	}
+}
+
+func TestSimulateOmahaDoubleBoardEquity(t *testing.T) {
+	hand := []Card{mustCard("As"), mustCard("Ad"), mustCard("Kh"), mustCard("Kc")}
+	equity := SimulateEquity(OmahaDoubleBoard{}, hand, 2000, 2)
+	if equity < 0.55 || equity > 0.8 {
+		t.Errorf("AAKK should be a favourite: equity = %.3f", equity)
+	}
+}
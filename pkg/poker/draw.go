@@ -0,0 +1,363 @@
+package poker
+
+import (
+	"math/bits"
+	mrand "math/rand"
+	"sort"
+	"sync"
+)
+
+// DrawGoal is the 5-card hand a draw pot is played for
+type DrawGoal int
+
+const (
+	// DrawHigh plays the draw for the best poker hand
+	DrawHigh DrawGoal = iota
+	// Draw27Low plays the draw for the best 2-7 lowball hand
+	Draw27Low
+)
+
+// Evaluate scores a complete 5-card hand for the goal (higher is better)
+func (g DrawGoal) Evaluate(hand []Card) int64 {
+	if g == Draw27Low {
+		return Lookup27Low(hand)
+	}
+	return Lookup5CardHigh(hand)
+}
+
+// strengthSamples is the number of random hands behind each Strength table
+const strengthSamples = 20000
+
+var (
+	strengthOnce   [2]sync.Once
+	strengthScores [2][]int64
+)
+
+// Strength returns the approximate fraction of random 5-card hands that the
+// hand beats for the goal, counting ties as half. Unlike Evaluate the result
+// can be averaged, so strategies use it to compare draws.
+func (g DrawGoal) Strength(hand []Card) float64 {
+	strengthOnce[g].Do(func() {
+		// Fixed seed: the table is a property of the goal, not of a simulation
+		r := mrand.New(mrand.NewSource(int64(g) + 1))
+		scores := make([]int64, strengthSamples)
+		deck := FullDeck()
+		for i := range scores {
+			for j := 0; j < 5; j++ {
+				k := r.Intn(len(deck)-j) + j
+				deck[j], deck[k] = deck[k], deck[j]
+			}
+			scores[i] = g.Evaluate(deck[:5])
+		}
+		sort.Slice(scores, func(i, j int) bool { return scores[i] < scores[j] })
+		strengthScores[g] = scores
+	})
+	return strengthOf(strengthScores[g], g.Evaluate(hand))
+}
+
+// strengthOf returns the fraction of the sorted sample scores below s,
+// counting ties as half
+func strengthOf(scores []int64, s int64) float64 {
+	below := sort.Search(len(scores), func(i int) bool { return scores[i] >= s })
+	above := sort.Search(len(scores), func(i int) bool { return scores[i] > s })
+	return (float64(below) + float64(above-below)/2) / float64(len(scores))
+}
+
+var (
+	flopOnce   sync.Once
+	flopScores []int64
+)
+
+// flopStrength returns the approximate fraction of random 5-card hands on
+// random flops whose Omaha high hand scores below score, counting ties as
+// half
+func flopStrength(score int64) float64 {
+	flopOnce.Do(func() {
+		// Fixed seed: the table is a property of the game, not of a simulation
+		r := mrand.New(mrand.NewSource(3))
+		flopScores = make([]int64, strengthSamples)
+		deck := FullDeck()
+		for i := range flopScores {
+			for j := 0; j < 8; j++ {
+				k := r.Intn(len(deck)-j) + j
+				deck[j], deck[k] = deck[k], deck[j]
+			}
+			flopScores[i] = BestOmahaHand(deck[:5], deck[5:8])
+		}
+		sort.Slice(flopScores, func(i, j int) bool { return flopScores[i] < flopScores[j] })
+	})
+	return strengthOf(flopScores, score)
+}
+
+// DrawView is what a player knows when choosing discards
+type DrawView struct {
+	// Board holds the community cards the player has seen, nil in games
+	// without a board
+	Board []Card
+	// Unseen holds every card that is neither in the player's hand, on
+	// Board nor dead: as far as the player can tell, replacements come from
+	// these
+	Unseen []Card
+	// Drawable is the number of cards that can be drawn: the stub plus the
+	// muck, which is shuffled back in when the stub runs short
+	Drawable int
+}
+
+// Discards is a set of positions in a hand of up to 8 cards, bit i for
+// position i
+type Discards uint8
+
+// NewDiscards returns the set of the given positions
+func NewDiscards(positions ...int) Discards {
+	var d Discards
+	for _, i := range positions {
+		d |= 1 << i
+	}
+	return d
+}
+
+// Has reports whether position i is discarded
+func (d Discards) Has(i int) bool { return d&(1<<i) != 0 }
+
+// Count returns the number of discarded cards
+func (d Discards) Count() int { return bits.OnesCount8(uint8(d)) }
+
+// DrawStrategy decides which cards a player throws away in one draw round
+type DrawStrategy interface {
+	// Discard returns the positions in the 5-card hand to replace, at most
+	// v.Drawable of them. The slices of v must not be modified; r is the
+	// caller's random source for strategies that sample.
+	Discard(r *mrand.Rand, hand []Card, goal DrawGoal, v DrawView) Discards
+}
+
+// DefaultDrawStrategy is used by draw games that don't set a strategy
+var DefaultDrawStrategy DrawStrategy = KeepBestN{N: 4}
+
+// Draw replaces the cards of hand chosen by s, in place, with cards from
+// d.Deck and adds the discards to d.Muck; board is the community cards the
+// player has seen. When the deck runs short the muck is shuffled back in
+// first, as at a real table. If even that leaves too few cards, only the
+// discards at the highest positions are replaced and the player keeps the
+// rest.
+func Draw(r *mrand.Rand, hand, board []Card, d *Deal, goal DrawGoal, s DrawStrategy) {
+	d.unseen = unseenCards(d.unseen[:0], hand, board, d.Dead)
+	v := DrawView{Board: board, Unseen: d.unseen, Drawable: len(d.Deck) + len(d.Muck)}
+	discards := s.Discard(r, hand, goal, v)
+	if discards.Count() > len(d.Deck) {
+		d.shuffleInMuck()
+	}
+	for discards.Count() > len(d.Deck) {
+		discards &= discards - 1 // keep the lowest discarded position
+	}
+	drawn := d.Take(r, discards.Count())
+	for i := range hand {
+		if discards.Has(i) {
+			d.Muck = append(d.Muck, hand[i])
+			hand[i], drawn = drawn[0], drawn[1:]
+		}
+	}
+}
+
+// keepList is an ordered list of hand positions, best first
+type keepList struct {
+	pos [8]int
+	n   int
+}
+
+func (k *keepList) add(i int) {
+	k.pos[k.n] = i
+	k.n++
+}
+
+// addAll adds the positions of the cards in hand that match keep
+func (k *keepList) addAll(hand []Card, keep func(Card) bool) {
+	for i, c := range hand {
+		if keep(c) {
+			k.add(i)
+		}
+	}
+}
+
+// unseenCards appends to dst the cards that are neither in hand, on board
+// nor dead
+func unseenCards(dst, hand, board []Card, dead CardSet) []Card {
+	return AllCards.Minus(ToSet(hand)).Minus(ToSet(board)).Minus(dead).AppendCards(dst)
+}
+
+// KeepBestN is the "pat or keep the best N" heuristic: stand pat on a made
+// hand, otherwise keep at most N cards that work towards the goal. It
+// decides from the draw hand alone and ignores the board.
+type KeepBestN struct {
+	N int
+}
+
+// Discard implements DrawStrategy
+func (k KeepBestN) Discard(r *mrand.Rand, hand []Card, goal DrawGoal, v DrawView) Discards {
+	var keep keepList
+	if goal == Draw27Low {
+		keep27Low(hand, &keep)
+	} else {
+		keepHigh(hand, &keep)
+	}
+	if keep.n > k.N && keep.n < len(hand) {
+		// keepers are listed best first
+		keep.n = k.N
+	}
+	discards := Discards(1<<len(hand) - 1)
+	for _, i := range keep.pos[:keep.n] {
+		discards &^= 1 << i
+	}
+	return discards
+}
+
+// keepHigh adds the positions worth keeping for a high hand, best first
+func keepHigh(hand []Card, keep *keepList) {
+	if Evaluate5CardHigh(hand) >= int64(Straight)*13*13*13*13*13 {
+		keep.addAll(hand, func(Card) bool { return true }) // made straight or better
+		return
+	}
+	var ranks [13]int
+	var suits [4]int
+	for _, c := range hand {
+		ranks[c.Rank()]++
+		suits[c.Suit()]++
+	}
+	// Paired cards, bigger groups and higher ranks first
+	for cnt := 4; cnt >= 2; cnt-- {
+		for r := 12; r >= 0; r-- {
+			if ranks[r] == cnt {
+				keep.addAll(hand, func(c Card) bool { return c.Rank() == r })
+			}
+		}
+	}
+	if keep.n > 0 {
+		return
+	}
+	// Four to a flush
+	for s, cnt := range suits {
+		if cnt == 4 {
+			keep.addAll(hand, func(c Card) bool { return c.Suit() == s })
+			return
+		}
+	}
+	// Four to an open-ended straight
+	for low := 0; low+3 < 12; low++ {
+		if ranks[low] > 0 && ranks[low+1] > 0 && ranks[low+2] > 0 && ranks[low+3] > 0 {
+			keep.addAll(hand, func(c Card) bool { return c.Rank() >= low && c.Rank() <= low+3 })
+			return
+		}
+	}
+	// Otherwise the two highest cards
+	highestFirst(hand, keep)
+	keep.n = 2
+}
+
+// keep27Low adds the positions worth keeping for a 2-7 lowball hand, best first
+func keep27Low(hand []Card, keep *keepList) {
+	var ranks [13]int
+	for _, c := range hand {
+		ranks[c.Rank()]++
+	}
+	top := -1
+	for r := 12; r >= 0; r-- {
+		if ranks[r] > 0 {
+			top = r
+			break
+		}
+	}
+	// Stand pat on a made nine-low or better (no pair, straight or flush)
+	if top <= 7 && evaluate5Card(hand, false) < int64(OnePair)*13*13*13*13*13 {
+		keep.addAll(hand, func(Card) bool { return true })
+		return
+	}
+	// Otherwise keep one card of each rank from the deuce to the eight, at
+	// most four of them since five would be a straight or a flush
+	for r := 0; r <= 6 && keep.n < 4; r++ {
+		for i, c := range hand {
+			if c.Rank() == r {
+				keep.add(i)
+				break
+			}
+		}
+	}
+}
+
+// MaxEVDraw searches every discard for the one with the highest expected
+// value after the draw, estimated from Samples random draws per discard
+// (every draw is enumerated when there are fewer). Replacements are drawn
+// from the cards the player hasn't seen, and discards of more than
+// v.Drawable cards are not considered. The value of a hand is its Strength
+// for the goal plus, once a flop is out, the strength of its Omaha high
+// hand, so both pots of a Drawmaha hand count.
+type MaxEVDraw struct {
+	Samples int
+}
+
+// Discard implements DrawStrategy
+func (m MaxEVDraw) Discard(r *mrand.Rand, hand []Card, goal DrawGoal, v DrawView) Discards {
+	bestEV := drawValue(hand, goal, v.Board) // standing pat
+	var best Discards
+	var trialBuf [8]Card
+	trial := trialBuf[:len(hand)]
+	// Partial shuffles of any ordering of the unseen cards give uniform
+	// draws, so the pool is copied once and reshuffled in place per sample
+	var poolBuf [52]Card
+	pool := append(poolBuf[:0], v.Unseen...)
+	for discards := Discards(1); discards < 1<<len(hand); discards++ {
+		if discards.Count() > v.Drawable || discards.Count() > len(pool) {
+			continue
+		}
+		copy(trial, hand)
+		total, n := 0.0, 0
+		if discards.Count() == 1 && len(pool) <= m.Samples {
+			pos := bits.TrailingZeros8(uint8(discards))
+			for _, c := range pool {
+				trial[pos] = c
+				total += drawValue(trial, goal, v.Board)
+				n++
+			}
+		} else {
+			for s := 0; s < m.Samples; s++ {
+				drawn := 0
+				for pos := range trial {
+					if !discards.Has(pos) {
+						continue
+					}
+					j := r.Intn(len(pool)-drawn) + drawn
+					pool[drawn], pool[j] = pool[j], pool[drawn]
+					trial[pos] = pool[drawn]
+					drawn++
+				}
+				total += drawValue(trial, goal, v.Board)
+				n++
+			}
+		}
+		if n > 0 && total/float64(n) > bestEV {
+			bestEV, best = total/float64(n), discards
+		}
+	}
+	return best
+}
+
+// drawValue is what MaxEVDraw maximises: the Strength of hand for goal and,
+// given a flop, the strength of the Omaha high hand it makes there. The
+// Omaha part scores the made hand only, not its draws.
+func drawValue(hand []Card, goal DrawGoal, board []Card) float64 {
+	v := goal.Strength(hand)
+	if len(board) >= 3 {
+		v += flopStrength(BestOmahaHand(hand, board[:3]))
+	}
+	return v
+}
+
+// highestFirst adds the positions of hand ordered by descending rank
+func highestFirst(hand []Card, keep *keepList) {
+	for i := range hand {
+		j := keep.n
+		keep.add(i)
+		for ; j > 0 && hand[keep.pos[j-1]].Rank() < hand[i].Rank(); j-- {
+			keep.pos[j] = keep.pos[j-1]
+		}
+		keep.pos[j] = i
+	}
+}
@@ -0,0 +1,212 @@
+package poker
+
+import (
+	"testing"
+)
+
+func TestKeepBestNDiscard(t *testing.T) {
+	tests := []struct {
+		name     string
+		hand     []Card
+		goal     DrawGoal
+		n        int
+		discards Discards
+	}{
+		{
+			name:     "Pat straight",
+			hand:     []Card{mustCard("6s"), mustCard("5d"), mustCard("4h"), mustCard("3c"), mustCard("2s")},
+			goal:     DrawHigh,
+			n:        4,
+			discards: 0,
+		},
+		{
+			name:     "Pat quads",
+			hand:     []Card{mustCard("As"), mustCard("Ad"), mustCard("Ah"), mustCard("Ac"), mustCard("2s")},
+			goal:     DrawHigh,
+			n:        4,
+			discards: 0,
+		},
+		{
+			name:     "Keep a pair",
+			hand:     []Card{mustCard("Ks"), mustCard("9d"), mustCard("9h"), mustCard("4c"), mustCard("2s")},
+			goal:     DrawHigh,
+			n:        4,
+			discards: NewDiscards(0, 3, 4),
+		},
+		{
+			name:     "Draw to the open-ended straight",
+			hand:     []Card{mustCard("2s"), mustCard("3d"), mustCard("4h"), mustCard("5c"), mustCard("Ks")},
+			goal:     DrawHigh,
+			n:        4,
+			discards: NewDiscards(4),
+		},
+		{
+			name:     "Draw to the flush",
+			hand:     []Card{mustCard("2s"), mustCard("9s"), mustCard("Js"), mustCard("5c"), mustCard("Ks")},
+			goal:     DrawHigh,
+			n:        4,
+			discards: NewDiscards(3),
+		},
+		{
+			name:     "Keep two high cards",
+			hand:     []Card{mustCard("2s"), mustCard("9d"), mustCard("Js"), mustCard("5c"), mustCard("Kh")},
+			goal:     DrawHigh,
+			n:        4,
+			discards: NewDiscards(0, 1, 3),
+		},
+		{
+			name:     "Pat eight-low",
+			hand:     []Card{mustCard("8s"), mustCard("6d"), mustCard("4h"), mustCard("3c"), mustCard("2s")},
+			goal:     Draw27Low,
+			n:        4,
+			discards: 0,
+		},
+		{
+			name:     "Break the 2-7 straight",
+			hand:     []Card{mustCard("6s"), mustCard("5d"), mustCard("4h"), mustCard("3c"), mustCard("2s")},
+			goal:     Draw27Low,
+			n:        4,
+			discards: NewDiscards(0),
+		},
+		{
+			name:     "Throw the king and the pair card",
+			hand:     []Card{mustCard("2s"), mustCard("3d"), mustCard("3h"), mustCard("7c"), mustCard("Ks")},
+			goal:     Draw27Low,
+			n:        4,
+			discards: NewDiscards(2, 4),
+		},
+		{
+			name:     "Ace is not a low card",
+			hand:     []Card{mustCard("As"), mustCard("3d"), mustCard("4h"), mustCard("7c"), mustCard("Ks")},
+			goal:     Draw27Low,
+			n:        4,
+			discards: NewDiscards(0, 4),
+		},
+		{
+			name:     "Keep at most N",
+			hand:     []Card{mustCard("As"), mustCard("Ad"), mustCard("Kh"), mustCard("Kc"), mustCard("2s")},
+			goal:     DrawHigh,
+			n:        2,
+			discards: NewDiscards(2, 3, 4),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := KeepBestN{N: tt.n}.Discard(testRand(), tt.hand, tt.goal, DrawView{})
+			if got != tt.discards {
+				t.Errorf("Discard() = %05b, want %05b", got, tt.discards)
+			}
+		})
+	}
+}
+
+func TestMaxEVDrawDiscard(t *testing.T) {
+	tests := []struct {
+		name     string
+		hand     []Card
+		board    []Card
+		goal     DrawGoal
+		discards Discards
+	}{
+		{
+			name:     "Stand pat on the nuts",
+			hand:     []Card{mustCard("As"), mustCard("Ks"), mustCard("Qs"), mustCard("Js"), mustCard("Ts")},
+			goal:     DrawHigh,
+			discards: 0,
+		},
+		{
+			name:     "Break the 2-7 straight from the top",
+			hand:     []Card{mustCard("6s"), mustCard("5d"), mustCard("4h"), mustCard("3c"), mustCard("2s")},
+			goal:     Draw27Low,
+			discards: NewDiscards(0),
+		},
+		{
+			name:     "Draw to the 2-7 without a flop",
+			hand:     []Card{mustCard("As"), mustCard("Ks"), mustCard("7c"), mustCard("5d"), mustCard("2h")},
+			goal:     Draw27Low,
+			discards: NewDiscards(0, 1),
+		},
+		{
+			name:     "Keep a flopped royal flush for the Omaha pot",
+			hand:     []Card{mustCard("As"), mustCard("Ks"), mustCard("7c"), mustCard("5d"), mustCard("2h")},
+			board:    []Card{mustCard("Qs"), mustCard("Js"), mustCard("Ts")},
+			goal:     Draw27Low,
+			discards: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := DrawView{Board: tt.board, Unseen: unseenCards(nil, tt.hand, tt.board, 0), Drawable: 5}
+			got := MaxEVDraw{Samples: 200}.Discard(testRand(), tt.hand, tt.goal, v)
+			if got != tt.discards {
+				t.Errorf("Discard() = %05b, want %05b", got, tt.discards)
+			}
+		})
+	}
+}
+
+func TestMaxEVDrawShortDeck(t *testing.T) {
+	// Two cards left to draw, though the player can't tell which
+	hand := []Card{mustCard("Ks"), mustCard("Qd"), mustCard("Jh"), mustCard("9c"), mustCard("8s")}
+	v := DrawView{Unseen: unseenCards(nil, hand, nil, 0), Drawable: 2}
+	if got := (MaxEVDraw{Samples: 20}).Discard(testRand(), hand, Draw27Low, v); got.Count() > 2 {
+		t.Errorf("Discard() = %05b, want at most 2 cards", got)
+	}
+}
+
+func TestMaxEVDrawFullTable(t *testing.T) {
+	// Eight players drawing from a 7-card stub and the muck
+	g := Drawmaha27{Strategy: MaxEVDraw{Samples: 20}}
+	equity := SimulateEquity(g, []Card{mustCard("Ks"), mustCard("Qd"), mustCard("Jh"), mustCard("Tc")}, 200, 8)
+	if equity < 0 || equity > 1 {
+		t.Errorf("equity = %.3f, want a share of the pot", equity)
+	}
+}
+
+func TestDrawShortDeckAndMuck(t *testing.T) {
+	hand := []Card{mustCard("As"), mustCard("Kd"), mustCard("9h"), mustCard("7c"), mustCard("2s")}
+	d := &Deal{Deck: []Card{mustCard("3c")}, Muck: []Card{mustCard("4c")}}
+
+	// Three discards but only two cards to draw: the lowest discard is kept
+	Draw(testRand(), hand, nil, d, DrawHigh, KeepBestN{N: 2})
+	if hand[0] != mustCard("As") || hand[1] != mustCard("Kd") || hand[2] != mustCard("9h") {
+		t.Fatalf("Draw() = %v, want As Kd 9h kept", hand)
+	}
+	if got := ToSet(hand[3:]); got != ToSet([]Card{mustCard("3c"), mustCard("4c")}) {
+		t.Errorf("drew %v, want 3c 4c", got)
+	}
+	if len(d.Deck) != 0 || len(d.Muck) != 2 {
+		t.Errorf("deck has %d cards and muck %d, want 0 and the 2 discards", len(d.Deck), len(d.Muck))
+	}
+}
+
+func TestDrawStrength(t *testing.T) {
+	nuts := []Card{mustCard("7s"), mustCard("5d"), mustCard("4h"), mustCard("3c"), mustCard("2s")}
+	if s := Draw27Low.Strength(nuts); s < 0.99 {
+		t.Errorf("7-5-4-3-2 should beat almost every 2-7 hand, Strength = %.3f", s)
+	}
+	if s := DrawHigh.Strength(nuts); s > 0.5 {
+		t.Errorf("7-5-4-3-2 should be a weak high hand, Strength = %.3f", s)
+	}
+}
+
+func TestDrawReshufflesMuck(t *testing.T) {
+	hand := []Card{mustCard("As"), mustCard("Kd"), mustCard("9h"), mustCard("7c"), mustCard("2s")}
+	deck := []Card{mustCard("3c")}
+	muck := []Card{mustCard("4c"), mustCard("5c"), mustCard("6c")}
+
+	// Keeping the two highest cards needs three replacements from a one card stub
+	d := &Deal{Deck: deck, Muck: muck}
+	Draw(testRand(), hand, nil, d, DrawHigh, KeepBestN{N: 2})
+	if hand[0] != mustCard("As") || hand[1] != mustCard("Kd") {
+		t.Fatalf("Draw() = %v, want As Kd kept", hand)
+	}
+	if len(d.Deck) != 1 {
+		t.Errorf("deck has %d cards left, want 1", len(d.Deck))
+	}
+	if len(d.Muck) != 3 {
+		t.Errorf("muck has %d cards, want the 3 fresh discards", len(d.Muck))
+	}
+}
@@ -0,0 +1,89 @@
+package poker
+
+import (
+	"testing"
+)
+
+func TestEvaluateDrawmaha27(t *testing.T) {
+	board := []Card{
+		mustCard("As"), mustCard("Ks"), mustCard("Qs"), mustCard("9d"), mustCard("3h"),
+	}
+	tests := []struct {
+		name       string
+		hand       []Card
+		wantBetter []Card
+		omahaWins  bool
+		lowWins    bool
+	}{
+		{
+			name: "Royal flush vs number one",
+			hand: []Card{
+				mustCard("Js"), mustCard("Ts"), mustCard("8c"), mustCard("8d"), mustCard("8h"),
+			},
+			wantBetter: []Card{
+				mustCard("7c"), mustCard("5d"), mustCard("4h"), mustCard("3c"), mustCard("2d"),
+			},
+			omahaWins: true,
+			lowWins:   false,
+		},
+		{
+			name: "Only two hole cards play in Omaha",
+			hand: []Card{
+				mustCard("Ah"), mustCard("Ad"), mustCard("7c"), mustCard("5d"), mustCard("2h"),
+			},
+			wantBetter: []Card{
+				mustCard("2s"), mustCard("4s"), mustCard("6s"), mustCard("8s"), mustCard("Kd"),
+			},
+			omahaWins: false,
+			lowWins:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			omaha1, low1 := EvaluateDrawmaha27(tt.hand, board)
+			omaha2, low2 := EvaluateDrawmaha27(tt.wantBetter, board)
+			if got := omaha1 > omaha2; got != tt.omahaWins {
+				t.Errorf("Omaha half: hand wins = %v, want %v (score1=%d, score2=%d)", got, tt.omahaWins, omaha1, omaha2)
+			}
+			if got := low1 > low2; got != tt.lowWins {
+				t.Errorf("2-7 half: hand wins = %v, want %v (score1=%d, score2=%d)", got, tt.lowWins, low1, low2)
+			}
+		})
+	}
+}
+
+func TestSimulateDrawmaha27Equity(t *testing.T) {
+	tests := []struct {
+		name      string
+		hand      []Card
+		minEquity float64
+		maxEquity float64
+	}{
+		{
+			name: "Smooth 2-7 draw",
+			hand: []Card{
+				mustCard("2s"), mustCard("3d"), mustCard("4h"), mustCard("7c"),
+			},
+			minEquity: 0.5,
+			maxEquity: 0.8,
+		},
+		{
+			name: "Four aces can't win the 2-7 half",
+			hand: []Card{
+				mustCard("As"), mustCard("Ad"), mustCard("Ah"), mustCard("Ac"),
+			},
+			minEquity: 0.1,
+			maxEquity: 0.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			equity := SimulateEquity(Drawmaha27{}, tt.hand, 2000, 2)
+			if equity < tt.minEquity || equity > tt.maxEquity {
+				t.Errorf("equity = %.3f, want between %.3f and %.3f", equity, tt.minEquity, tt.maxEquity)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package poker
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// EquityTableVersion is the version written by EquityTable.Write. Tables
+// of any other version are rejected as stale, so it is bumped whenever the
+// format or the rules of a game change the stored equities.
+const EquityTableVersion = 2
+
+// ErrStaleTable is returned when an equity table doesn't match this build:
+// a different format version, game set or number of hand classes.
+var ErrStaleTable = errors.New("equity table is stale")
+
+// EquityTable holds precomputed equities for every 4-card starting hand
+// class (see HandClasses), so the best game can be looked up instead of
+// simulated.
+type EquityTable struct {
+	// Games are the game names, in the order of each row of Equities
+	Games []string
+	// Players is the table size the equities were simulated for
+	Players int
+	// Iterations is the number of deals simulated per hand class and game
+	Iterations int
+	// Seed is the Simulator seed the table was built with
+	Seed int64
+	// Equities holds one row per hand class, indexed like HandClasses
+	Equities [][]float64
+}
+
+// BuildEquityTable sweeps every hand class, simulating iters deals of
+// every implemented game that seats `players`. progress, if not nil, is
+// called after each class.
+func BuildEquityTable(ctx context.Context, s Simulator, iters, players int, progress func(done, total int)) (*EquityTable, error) {
+	games := candidateGames(nil, players, 0)
+	t := &EquityTable{Players: players, Iterations: iters, Seed: s.Seed}
+	for _, g := range games {
+		t.Games = append(t.Games, g.Name())
+	}
+	classes := HandClasses()
+	t.Equities = make([][]float64, len(classes))
+	for i, c := range classes {
+		row := make([]float64, len(games))
+		for j, g := range games {
+			res, err := s.Equity(ctx, g, c.Cards, iters, players)
+			if err != nil {
+				return nil, err
+			}
+			row[j] = res.Equity
+		}
+		t.Equities[i] = row
+		if progress != nil {
+			progress(i+1, len(classes))
+		}
+	}
+	return t, nil
+}
+
+// Write stores the table as CSV: a "#" header line with the version and
+// settings, a column header, then one row per hand class giving its index,
+// canonical hand and the equity of each game.
+func (t *EquityTable) Write(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "# pickem-equities v%d players=%d iterations=%d seed=%d\n",
+		EquityTableVersion, t.Players, t.Iterations, t.Seed)
+	cw := csv.NewWriter(bw)
+	cw.Write(append([]string{"class", "hand"}, t.Games...))
+	classes := HandClasses()
+	for i, row := range t.Equities {
+		rec := make([]string, 0, len(row)+2)
+		rec = append(rec, strconv.Itoa(i), handString(classes[i].Cards))
+		for _, e := range row {
+			rec = append(rec, strconv.FormatFloat(e, 'f', 5, 64))
+		}
+		cw.Write(rec)
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// ReadEquityTable parses a table written by Write. It returns
+// ErrStaleTable if the table was written for another version, game set or
+// hand class numbering.
+func ReadEquityTable(r io.Reader) (*EquityTable, error) {
+	br := bufio.NewReader(r)
+	header, err := br.ReadString('\n')
+	if err != nil {
+		return nil, fmt.Errorf("reading equity table header: %w", err)
+	}
+	t := &EquityTable{}
+	var version int
+	if _, err := fmt.Sscanf(header, "# pickem-equities v%d players=%d iterations=%d seed=%d",
+		&version, &t.Players, &t.Iterations, &t.Seed); err != nil {
+		return nil, fmt.Errorf("invalid equity table header %q", strings.TrimSpace(header))
+	}
+	if version != EquityTableVersion {
+		return nil, fmt.Errorf("%w: version %d, want %d", ErrStaleTable, version, EquityTableVersion)
+	}
+
+	cr := csv.NewReader(br)
+	cols, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading equity table columns: %w", err)
+	}
+	if len(cols) < 3 {
+		return nil, fmt.Errorf("equity table has no games")
+	}
+	t.Games = cols[2:]
+	var want []string
+	for _, g := range candidateGames(nil, t.Players, 0) {
+		want = append(want, g.Name())
+	}
+	if !slices.Equal(t.Games, want) {
+		return nil, fmt.Errorf("%w: games %v, want %v", ErrStaleTable, t.Games, want)
+	}
+
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if i, err := strconv.Atoi(rec[0]); err != nil || i != len(t.Equities) {
+			return nil, fmt.Errorf("equity table row %q out of order", rec[0])
+		}
+		row := make([]float64, len(t.Games))
+		for j := range row {
+			if row[j], err = strconv.ParseFloat(rec[j+2], 64); err != nil {
+				return nil, fmt.Errorf("equity table row %s: %w", rec[0], err)
+			}
+		}
+		t.Equities = append(t.Equities, row)
+	}
+	if len(t.Equities) != len(HandClasses()) {
+		return nil, fmt.Errorf("%w: %d hand classes, want %d", ErrStaleTable, len(t.Equities), len(HandClasses()))
+	}
+	return t, nil
+}
+
+// LoadEquityTable reads the table stored at path
+func LoadEquityTable(path string) (*EquityTable, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadEquityTable(f)
+}
+
+// LookupBestGame answers PickBestGame from the table: the games ranked by
+// their stored equity for the hand's class, ties broken as in PickBestGame.
+// Given games, it chooses among those only; it returns an error if one that
+// fits the table isn't in it (see Has).
+func (t *EquityTable) LookupBestGame(my4 []Card, games ...Game) (Selection, error) {
+	row := t.Equities[HandClassIndex(my4)]
+	var results []GameResult
+	for _, g := range candidateGames(games, t.Players, 0) {
+		j := slices.Index(t.Games, g.Name())
+		if j < 0 {
+			return Selection{}, fmt.Errorf("game %s is not in the equity table", g.Name())
+		}
+		res := EquityResult{Equity: row[j], Iterations: t.Iterations}
+		results = append(results, GameResult{Game: g, EquityResult: res, Score: row[j]})
+	}
+	return newSelection(appendSkipped(results, games, t.Players, 0)), nil
+}
+
+// Has reports whether the table holds equities for every one of games that
+// is implemented and seats the table's players
+func (t *EquityTable) Has(games ...Game) bool {
+	for _, g := range games {
+		if Implemented(g) && unfit(g, t.Players, 0) == nil && !slices.Contains(t.Games, g.Name()) {
+			return false
+		}
+	}
+	return true
+}
+
+// handString formats cards separated by spaces, as ParseHand reads them
+func handString(cards []Card) string {
+	s := make([]string, len(cards))
+	for i, c := range cards {
+		s[i] = c.String()
+	}
+	return strings.Join(s, " ")
+}
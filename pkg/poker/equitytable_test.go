@@ -0,0 +1,135 @@
+package poker
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// testEquityTable returns a table with made-up equities that favour
+// Badugi for hands with a deuce and HiDuGi otherwise
+func testEquityTable() *EquityTable {
+	t := &EquityTable{Players: 2, Iterations: 10, Seed: 7}
+	for _, g := range candidateGames(nil, 2, 0) {
+		t.Games = append(t.Games, g.Name())
+	}
+	for _, c := range HandClasses() {
+		row := make([]float64, len(t.Games))
+		for j := range row {
+			row[j] = 0.25
+		}
+		if c.Cards[3].Rank() == 0 {
+			row[slices.Index(t.Games, "Badugi")] = 0.75
+		} else {
+			row[slices.Index(t.Games, "HiDuGi")] = 0.6
+		}
+		t.Equities = append(t.Equities, row)
+	}
+	return t
+}
+
+func TestEquityTableRoundTrip(t *testing.T) {
+	want := testEquityTable()
+	var buf bytes.Buffer
+	if err := want.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ReadEquityTable(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("table changed in a round trip: got %+v", got.Games)
+	}
+
+	tests := []struct {
+		hand string
+		want string
+	}{
+		{"Ac Kd 2h 3s", "Badugi"},
+		{"As Ks Qh Jd", "HiDuGi"},
+	}
+	for _, tt := range tests {
+		hand, _ := ParseHand(tt.hand)
+		sel, err := got.LookupBestGame(hand)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sel.Best.Name() != tt.want {
+			t.Errorf("LookupBestGame(%s) = %s, want %s", tt.hand, sel.Best.Name(), tt.want)
+		}
+		if equities := sel.Equities(); len(equities) != len(want.Games) {
+			t.Errorf("LookupBestGame(%s) returned %d equities, want %d", tt.hand, len(equities), len(want.Games))
+		}
+	}
+}
+
+func TestReadEquityTableStale(t *testing.T) {
+	var buf bytes.Buffer
+	if err := testEquityTable().Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	valid := buf.String()
+	lines := strings.SplitN(valid, "\n", 3)
+
+	tests := []struct {
+		name  string
+		table string
+		stale bool
+	}{
+		{"Other version", strings.Replace(valid, fmt.Sprintf("v%d ", EquityTableVersion), "v1 ", 1), true},
+		{"Other games", lines[0] + "\n" + strings.Replace(lines[1], "Badugi", "Razz", 1) + "\n" + lines[2], true},
+		{"Missing rows", valid[:strings.LastIndex(strings.TrimSuffix(valid, "\n"), "\n")+1], true},
+		{"Bad header", "hello\n", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadEquityTable(strings.NewReader(tt.table))
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if errors.Is(err, ErrStaleTable) != tt.stale {
+				t.Errorf("err = %v, stale = %v", err, tt.stale)
+			}
+		})
+	}
+}
+
+func TestEquityTableTableSize(t *testing.T) {
+	// A 10-player table holds only the games that seat ten
+	ten := testEquityTable()
+	ten.Players = 10
+	ten.Games = nil
+	for _, g := range candidateGames(nil, 10, 0) {
+		ten.Games = append(ten.Games, g.Name())
+	}
+	for i := range ten.Equities {
+		ten.Equities[i] = ten.Equities[i][:len(ten.Games)]
+	}
+	var buf bytes.Buffer
+	if err := ten.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ReadEquityTable(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slices.Contains(got.Games, "Badugi") {
+		t.Errorf("10-player table has Badugi: %v", got.Games)
+	}
+	if !got.Has(BadugiGame{}, OmahaDoubleBoard{}) {
+		t.Error("Has wants a game that doesn't seat the table")
+	}
+
+	// A game the table should hold but doesn't is an error, not a panic
+	got.Games[0] = "Renamed"
+	hand, _ := ParseHand("As Ks Qh Jd")
+	if _, err := got.LookupBestGame(hand); err == nil {
+		t.Error("LookupBestGame succeeded without every game")
+	}
+}
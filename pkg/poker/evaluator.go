@@ -1,8 +1,6 @@
 package poker
 
-import (
-	"math"
-)
+import "math/bits"
 
 // Hand category constants (higher is better)
 const (
@@ -22,15 +20,33 @@ func Evaluate5CardHigh(hand []Card) int64 {
 	if len(hand) != 5 {
 		panic("evaluate5CardHigh expects 5 cards")
 	}
+	return evaluate5Card(hand, true)
+}
+
+// lowball27Max is one above the highest score evaluate5Card can return.
+const lowball27Max = int64(StraightFlush+1) * 13 * 13 * 13 * 13 * 13
+
+// Evaluate27Low evaluates a 5-card 2-7 lowball hand (higher is better).
+// Aces are always high and straights and flushes count against the hand,
+// so the best hand is 7-5-4-3-2 offsuit and A-2-3-4-5 is just ace high.
+func Evaluate27Low(hand []Card) int64 {
+	if len(hand) != 5 {
+		panic("evaluate27Low expects 5 cards")
+	}
+	return lowball27Max - evaluate5Card(hand, false)
+}
+
+// evaluate5Card scores a 5-card high hand. wheel controls whether A2345
+// counts as a straight.
+func evaluate5Card(hand []Card, wheel bool) int64 {
+	set := NewCardSet(hand...)
 	ranks := make([]int, 13)
-	suits := make([]int, 4)
-	for _, c := range hand {
-		ranks[c.Rank()]++
-		suits[c.Suit()]++
+	for r := range ranks {
+		ranks[r] = set.RankCount(r)
 	}
 	isFlush := false
-	for _, s := range suits {
-		if s == 5 {
+	for s := 0; s < 4; s++ {
+		if set.SuitCount(s) == 5 {
 			isFlush = true
 			break
 		}
@@ -50,7 +66,7 @@ func Evaluate5CardHigh(hand []Card) int64 {
 		}
 	}
 	// wheel check
-	if consec == 4 && ranks[12] > 0 && ranks[3] > 0 && ranks[2] > 0 && ranks[1] > 0 && ranks[0] > 0 {
+	if wheel && consec == 4 && ranks[12] > 0 && ranks[3] > 0 && ranks[2] > 0 && ranks[1] > 0 && ranks[0] > 0 {
 		top = 3 // straight to 5
 	}
 	isStraight := top != -1
@@ -90,50 +106,157 @@ func Evaluate5CardHigh(hand []Card) int64 {
 		cat = HighCard
 	}
 
-	// naive kicker encoding: combine sorted ranks descending into value
-	vals := make([]int, 0, 5)
-	for r := 12; r >= 0; r-- {
-		for i := 0; i < ranks[r]; i++ {
-			vals = append(vals, r)
-		}
+	if isStraight {
+		// only the top card matters, so the wheel ranks below a six-high straight
+		return cat*int64(13*13*13*13*13) + int64(top)
 	}
+	return cat*int64(13*13*13*13*13) + encodeKickers(ranks) // category has highest weight
+}
+
+// encodeKickers combines rank counts into a tie-break value: larger groups
+// first (the trips of a full house before its pair), then higher ranks.
+func encodeKickers(ranks []int) int64 {
 	var kicker int64
-	for _, v := range vals {
-		kicker = kicker*13 + int64(v)
+	for cnt := 4; cnt >= 1; cnt-- {
+		for r := 12; r >= 0; r-- {
+			if ranks[r] == cnt {
+				for i := 0; i < cnt; i++ {
+					kicker = kicker*13 + int64(r)
+				}
+			}
+		}
 	}
-	return cat*int64(13*13*13*13*13) + kicker // category has highest weight
+	return kicker
 }
 
-// EvaluateBadugi evaluates a Badugi hand – lower is better (4‑card low with unique suits)
-// We convert to HIGH by negating within a large offset so that higher = better overall
-func EvaluateBadugi(hand []Card) int64 {
-	// select up to 4 cards with distinct suits and ranks minimal lexicographically.
+// EvaluateA5Low evaluates a 5-card ace-to-five lowball hand (higher is
+// better). Aces are low and straights and flushes don't count, so the best
+// hand is 5-4-3-2-A and paired hands lose to any unpaired one.
+func EvaluateA5Low(hand []Card) int64 {
+	if len(hand) != 5 {
+		panic("evaluateA5Low expects 5 cards")
+	}
+	ranks := make([]int, 13)
+	for _, c := range hand {
+		ranks[aceLowRank(c)]++
+	}
+	var pairs, trips, quads int
+	for _, cnt := range ranks {
+		switch cnt {
+		case 2:
+			pairs++
+		case 3:
+			trips++
+		case 4:
+			quads++
+		}
+	}
+
+	var cat int64
+	switch {
+	case quads > 0:
+		cat = Quads
+	case trips > 0 && pairs > 0:
+		cat = FullHouse
+	case trips > 0:
+		cat = Trips
+	case pairs >= 2:
+		cat = TwoPair
+	case pairs > 0:
+		cat = OnePair
+	default:
+		cat = HighCard
+	}
+	return lowball27Max - (cat*int64(13*13*13*13*13) + encodeKickers(ranks))
+}
+
+// BadugiHand is a decoded badugi: the largest set of cards with distinct
+// ranks and suits, and among those the lowest
+type BadugiHand struct {
+	// Cards are the cards played, highest first (ace low)
+	Cards []Card
+}
+
+// aceLowRank returns the rank of c with aces low: ace 0, deuce 1 … king 12
+func aceLowRank(c Card) int { return (c.Rank() + 1) % 13 }
+
+// BestBadugi finds the best badugi in hand. More cards always win; equal
+// sizes compare from the highest card down, ace low.
+func BestBadugi(hand []Card) BadugiHand {
+	var best []Card
+	bestScore := int64(-1)
 	chosen := make([]Card, 0, 4)
-	usedSuit := [4]bool{}
-	usedRank := [13]bool{}
-	// brute force: iterate ranks ascending, pick first available card with unique suit.
-	for r := 0; r < 13 && len(chosen) < 4; r++ {
-		for s := 0; s < 4 && len(chosen) < 4; s++ {
-			c := Card(r*4 + s)
-			for _, h := range hand {
-				if h == c && !usedSuit[s] && !usedRank[r] {
-					chosen = append(chosen, h)
-					usedSuit[s] = true
-					usedRank[r] = true
-					break
-				}
+	// Every subset of at most 4 cards with distinct ranks and suits
+	for mask := 1; mask < 1<<len(hand); mask++ {
+		chosen = chosen[:0]
+		var ranks, suits int
+		ok := true
+		for i, c := range hand {
+			if mask&(1<<i) == 0 {
+				continue
+			}
+			if len(chosen) == 4 || ranks&(1<<c.Rank()) != 0 || suits&(1<<c.Suit()) != 0 {
+				ok = false
+				break
 			}
+			ranks |= 1 << c.Rank()
+			suits |= 1 << c.Suit()
+			chosen = append(chosen, c)
+		}
+		if !ok {
+			continue
+		}
+		sortBadugi(chosen)
+		if score := badugiScore(chosen); score > bestScore {
+			best, bestScore = append(best[:0], chosen...), score
+		}
+	}
+	return BadugiHand{Cards: best}
+}
+
+// sortBadugi orders cards highest first by badugi rank
+func sortBadugi(cards []Card) {
+	for i := 1; i < len(cards); i++ {
+		for j := i; j > 0 && aceLowRank(cards[j]) > aceLowRank(cards[j-1]); j-- {
+			cards[j], cards[j-1] = cards[j-1], cards[j]
 		}
 	}
-	// score: more cards better; then lower ranks better.
-	// Base score by number of cards (4 cards = 4000, 3 cards = 3000, etc)
-	score := int64(4-len(chosen)) * 100000
-	// Add rank values (lower is better in badugi)
-	for _, c := range chosen {
-		score = score*13 + int64(c.Rank())
+}
+
+// badugiScore scores cards sorted by sortBadugi: size first, then the
+// lowest ranks from the top card down (higher is better)
+func badugiScore(cards []Card) int64 {
+	var low int64
+	for i := 0; i < 4; i++ {
+		low *= 13
+		if i < len(cards) {
+			low += int64(12 - aceLowRank(cards[i]))
+		}
 	}
-	// invert so bigger is better (align with evaluate5CardHigh)
-	return math.MaxInt64/2 - score
+	return int64(len(cards))*13*13*13*13 + low
+}
+
+// Size returns the number of cards in the badugi (1–4)
+func (b BadugiHand) Size() int { return len(b.Cards) }
+
+// Score returns the badugi's strength, higher is better
+func (b BadugiHand) Score() int64 { return badugiScore(b.Cards) }
+
+// String renders the badugi highest first, e.g. "8-5-3-A"
+func (b BadugiHand) String() string {
+	out := ""
+	for i, c := range b.Cards {
+		if i > 0 {
+			out += "-"
+		}
+		out += rankToChar[c.Rank()]
+	}
+	return out
+}
+
+// EvaluateBadugi evaluates a Badugi hand (higher is better); see BestBadugi
+func EvaluateBadugi(hand []Card) int64 {
+	return BestBadugi(hand).Score()
 }
 
 // Evaluate4CardHigh evaluates a 4-card poker hand - category ranking (higher is better)
@@ -141,17 +264,16 @@ func Evaluate4CardHigh(hand []Card) int64 {
 	if len(hand) != 4 {
 		panic("evaluate4CardHigh expects 4 cards")
 	}
+	set := NewCardSet(hand...)
 	ranks := make([]int, 13)
-	suits := make([]int, 4)
-	for _, c := range hand {
-		ranks[c.Rank()]++
-		suits[c.Suit()]++
+	for r := range ranks {
+		ranks[r] = set.RankCount(r)
 	}
 
 	// Check for flush (4 cards of same suit)
 	isFlush := false
-	for _, s := range suits {
-		if s == 4 {
+	for s := 0; s < 4; s++ {
+		if set.SuitCount(s) == 4 {
 			isFlush = true
 			break
 		}
@@ -209,64 +331,137 @@ func Evaluate4CardHigh(hand []Card) int64 {
 		cat = 1 // High card
 	}
 
-	// Kicker encoding for 4 cards
-	vals := make([]int, 0, 4)
-	for r := 12; r >= 0; r-- {
-		for i := 0; i < ranks[r]; i++ {
-			vals = append(vals, r)
-		}
-	}
-	var kicker int64
-	for _, v := range vals {
-		kicker = kicker*13 + int64(v)
-	}
-	return cat*int64(13*13*13*13) + kicker
+	return cat*int64(13*13*13*13) + encodeKickers(ranks)
 }
 
 // EvaluateHiDuGi evaluates both high and badugi hands for HiDuGi split pot game
 func EvaluateHiDuGi(hand []Card) (int64, int64) {
-	highScore := Evaluate4CardHigh(hand)
-	badugiScore := EvaluateBadugi(hand)
+	highScore := Lookup4CardHigh(hand)
+	badugiScore := LookupBadugi(hand)
 	return highScore, badugiScore
 }
 
-// IsBadugi8OrBetter checks if the hand qualifies as 8-badugi or better
+// IsBadugi8OrBetter checks if the hand makes a 4-card badugi 8 or better
 func IsBadugi8OrBetter(hand []Card) bool {
-	// Get the badugi hand
-	chosen := make([]Card, 0, 4)
-	usedSuit := [4]bool{}
-	usedRank := [13]bool{}
-
-	for r := 0; r < 13 && len(chosen) < 4; r++ {
-		for s := 0; s < 4 && len(chosen) < 4; s++ {
-			c := Card(r*4 + s)
-			for _, h := range hand {
-				if h == c && !usedSuit[s] && !usedRank[r] {
-					chosen = append(chosen, h)
-					usedSuit[s] = true
-					usedRank[r] = true
-					break
+	b := BestBadugi(hand)
+	// aceLowRank of an eight is 7
+	return b.Size() == 4 && aceLowRank(b.Cards[0]) <= 7
+}
+
+// primeValue is the pip value of each rank when it is prime (2, 3, 5, 7,
+// J=11, K=13) and 0 otherwise. Aces count as 1 and are not prime.
+var primeValue = [13]int64{2, 3, 0, 5, 0, 7, 0, 0, 0, 11, 0, 13, 0}
+
+// EvaluatePrime evaluates a 4-card Prime hand (higher is better) under
+// provisional rules pending the house rules (see PrimeGame). Cards of
+// prime rank (2, 3, 5, 7, J, K) are the only ones that score: more prime
+// cards beat fewer, then the higher total of their values wins, and any
+// remaining tie is broken by the 4-card high hand.
+func EvaluatePrime(hand []Card) int64 {
+	if len(hand) != 4 {
+		panic("evaluatePrime expects 4 cards")
+	}
+	var count, sum int64
+	for _, c := range hand {
+		if v := primeValue[c.Rank()]; v > 0 {
+			count++
+			sum += v
+		}
+	}
+	// sum is at most 4*13, so 53 keeps count dominant; 9*13^4 bounds Evaluate4CardHigh
+	return (count*53+sum)*int64(9*13*13*13*13) + Evaluate4CardHigh(hand)
+}
+
+// BestOmahaHand returns the best 5-card high score made from exactly two
+// hole cards and three board cards, as every Omaha high game plays.
+func BestOmahaHand(hole []Card, board []Card) int64 {
+	best := int64(-1)
+	five := make([]Card, 5)
+	for i := 0; i < len(hole); i++ {
+		for j := i + 1; j < len(hole); j++ {
+			five[0], five[1] = hole[i], hole[j]
+			for a := 0; a < len(board); a++ {
+				for b := a + 1; b < len(board); b++ {
+					for c := b + 1; c < len(board); c++ {
+						five[2], five[3], five[4] = board[a], board[b], board[c]
+						if s := Lookup5CardHigh(five); s > best {
+							best = s
+						}
+					}
 				}
 			}
 		}
 	}
+	return best
+}
 
-	// Check if it's a 4-card badugi with highest card 8 or better
-	// In badugi, ace is low, so we need to check the actual high card
-	if len(chosen) == 4 {
-		maxRank := -1
-		for _, c := range chosen {
-			rank := c.Rank()
-			// Ace is low in badugi, treat as rank 0 for comparison
-			if rank == 12 {
-				rank = -1
-			}
-			if rank > maxRank {
-				maxRank = rank
+// BestOmahaLow8 returns the best A-5 low score made from exactly two hole
+// cards and three board cards, counting only lows of five different ranks
+// eight or lower. It returns NoQualify if there is no such low.
+func BestOmahaLow8(hole []Card, board []Card) int64 {
+	best := NoQualify
+	five := make([]Card, 5)
+	for i := 0; i < len(hole); i++ {
+		for j := i + 1; j < len(hole); j++ {
+			five[0], five[1] = hole[i], hole[j]
+			for a := 0; a < len(board); a++ {
+				for b := a + 1; b < len(board); b++ {
+					for c := b + 1; c < len(board); c++ {
+						five[2], five[3], five[4] = board[a], board[b], board[c]
+						if !isLow8(five) {
+							continue
+						}
+						if s := LookupA5Low(five); s > best {
+							best = s
+						}
+					}
+				}
 			}
 		}
-		// maxRank 6 = 8, so <= 6 means 8 or better
-		return maxRank <= 6
 	}
-	return false
+	return best
+}
+
+// isLow8 reports whether five cards are five different ranks, eight or
+// lower with aces low
+func isLow8(five []Card) bool {
+	ranks := 0
+	for _, c := range five {
+		ranks |= 1 << aceLowRank(c)
+	}
+	// aceLowRank of an eight is 7
+	return ranks < 1<<8 && bits.OnesCount(uint(ranks)) == 5
+}
+
+// EvaluateOmahaHiLo8 evaluates both halves of an Omaha Hi-Lo hand: the
+// Omaha high hand and the eight-or-better low, NoQualify without one
+func EvaluateOmahaHiLo8(hand []Card, board []Card) (int64, int64) {
+	return BestOmahaHand(hand, board), BestOmahaLow8(hand, board)
+}
+
+// EvaluateDrawmahaHi evaluates both halves of a Drawmaha-Hi hand: the Omaha
+// high hand made with the board, and the 5-card high draw hand
+func EvaluateDrawmahaHi(hand []Card, board []Card) (int64, int64) {
+	omahaScore := BestOmahaHand(hand, board)
+	highScore := Lookup5CardHigh(hand)
+	return omahaScore, highScore
+}
+
+// EvaluateDrawmaha27 evaluates both halves of a Drawmaha-2-7 hand: the Omaha
+// high hand made with the board, and the 5-card 2-7 lowball draw hand
+func EvaluateDrawmaha27(hand []Card, board []Card) (int64, int64) {
+	omahaScore := BestOmahaHand(hand, board)
+	lowScore := Lookup27Low(hand)
+	return omahaScore, lowScore
+}
+
+// EvaluateDoubleBoard evaluates an Omaha hand against both boards of a
+// double board game; board holds the first board followed by the second
+func EvaluateDoubleBoard(hand []Card, board []Card) (int64, int64) {
+	if len(board) != 10 {
+		panic("evaluateDoubleBoard expects two 5-card boards")
+	}
+	firstScore := BestOmahaHand(hand, board[:5])
+	secondScore := BestOmahaHand(hand, board[5:])
+	return firstScore, secondScore
 }
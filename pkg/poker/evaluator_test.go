@@ -1,6 +1,7 @@
 package poker
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -91,6 +92,36 @@ func TestEvaluate5CardHigh(t *testing.T) {
 			},
 			description: "Pair of aces",
 		},
+		{
+			name: "Higher trips win the full house",
+			hand: []Card{
+				mustCard("3s"), mustCard("3d"), mustCard("3h"), mustCard("Kc"), mustCard("Ks"),
+			},
+			wantBetter: []Card{
+				mustCard("2s"), mustCard("2d"), mustCard("2h"), mustCard("Ac"), mustCard("As"),
+			},
+			description: "Threes full of kings",
+		},
+		{
+			name: "Higher pair beats higher kickers",
+			hand: []Card{
+				mustCard("3s"), mustCard("3d"), mustCard("Ah"), mustCard("Kc"), mustCard("Js"),
+			},
+			wantBetter: []Card{
+				mustCard("2s"), mustCard("2d"), mustCard("Ah"), mustCard("Kc"), mustCard("Qs"),
+			},
+			description: "Pair of threes",
+		},
+		{
+			name: "Six-high straight beats the wheel",
+			hand: []Card{
+				mustCard("6s"), mustCard("5d"), mustCard("4h"), mustCard("3c"), mustCard("2s"),
+			},
+			wantBetter: []Card{
+				mustCard("5s"), mustCard("4d"), mustCard("3h"), mustCard("2c"), mustCard("As"),
+			},
+			description: "Six-high straight",
+		},
 	}
 
 	for _, tt := range tests {
@@ -131,6 +162,56 @@ func TestEvaluateBadugi(t *testing.T) {
 			},
 			description: "A234 beats A235",
 		},
+		{
+			name: "Ace is low",
+			hand: []Card{
+				mustCard("Ac"), mustCard("2d"), mustCard("3h"), mustCard("4s"),
+			},
+			wantBetter: []Card{
+				mustCard("2c"), mustCard("3d"), mustCard("4h"), mustCard("5s"),
+			},
+			description: "A234 beats 2345",
+		},
+		{
+			name: "Compared from the highest card down",
+			hand: []Card{
+				mustCard("8c"), mustCard("4d"), mustCard("3h"), mustCard("2s"),
+			},
+			wantBetter: []Card{
+				mustCard("8c"), mustCard("5d"), mustCard("2h"), mustCard("As"),
+			},
+			description: "8432 beats 852A",
+		},
+		{
+			name: "Worst 3-card badugi beats best 2-card badugi",
+			hand: []Card{
+				mustCard("Kc"), mustCard("Qd"), mustCard("Jh"), mustCard("Jc"),
+			},
+			wantBetter: []Card{
+				mustCard("Ac"), mustCard("2d"), mustCard("2c"), mustCard("Ad"),
+			},
+			description: "KQJ beats 2A",
+		},
+		{
+			name: "2-card badugi beats 1-card badugi",
+			hand: []Card{
+				mustCard("Kc"), mustCard("Qd"), mustCard("Qc"), mustCard("Kd"),
+			},
+			wantBetter: []Card{
+				mustCard("2c"), mustCard("3c"), mustCard("4c"), mustCard("5c"),
+			},
+			description: "KQ beats a single deuce",
+		},
+		{
+			name: "Low card doesn't block a bigger badugi",
+			hand: []Card{
+				mustCard("Ac"), mustCard("As"), mustCard("3c"), mustCard("4d"),
+			},
+			wantBetter: []Card{
+				mustCard("2c"), mustCard("3d"), mustCard("3c"), mustCard("2d"),
+			},
+			description: "43A (As 3c 4d) beats 32",
+		},
 	}
 
 	for _, tt := range tests {
@@ -144,6 +225,188 @@ func TestEvaluateBadugi(t *testing.T) {
 	}
 }
 
+func TestBestBadugi(t *testing.T) {
+	tests := []struct {
+		name string
+		hand []Card
+		want []Card
+	}{
+		{
+			name: "Rainbow hand plays every card",
+			hand: []Card{mustCard("2d"), mustCard("Ac"), mustCard("8s"), mustCard("5h")},
+			want: []Card{mustCard("8s"), mustCard("5h"), mustCard("2d"), mustCard("Ac")},
+		},
+		{
+			name: "Keeps the ace that doesn't block",
+			hand: []Card{mustCard("Ac"), mustCard("As"), mustCard("3c"), mustCard("4d")},
+			want: []Card{mustCard("4d"), mustCard("3c"), mustCard("As")},
+		},
+		{
+			name: "Drops the high card of a pair of suits",
+			hand: []Card{mustCard("Kc"), mustCard("2d"), mustCard("3h"), mustCard("4s"), mustCard("5c")},
+			want: []Card{mustCard("5c"), mustCard("4s"), mustCard("3h"), mustCard("2d")},
+		},
+		{
+			name: "Four of a suit plays the lowest",
+			hand: []Card{mustCard("9h"), mustCard("3h"), mustCard("Ah"), mustCard("Kh")},
+			want: []Card{mustCard("Ah")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BestBadugi(tt.hand)
+			if !reflect.DeepEqual(got.Cards, tt.want) {
+				t.Errorf("BestBadugi(%v) = %v, want %v", tt.hand, got.Cards, tt.want)
+			}
+		})
+	}
+
+	if got := BestBadugi([]Card{mustCard("8s"), mustCard("5h"), mustCard("2d"), mustCard("Ac")}).String(); got != "8-5-2-A" {
+		t.Errorf("String() = %q, want %q", got, "8-5-2-A")
+	}
+}
+
+func TestEvaluate27Low(t *testing.T) {
+	tests := []struct {
+		name        string
+		hand        []Card
+		wantBetter  []Card
+		description string
+	}{
+		{
+			name: "Number one beats eight-six",
+			hand: []Card{
+				mustCard("7s"), mustCard("5d"), mustCard("4h"), mustCard("3c"), mustCard("2s"),
+			},
+			wantBetter: []Card{
+				mustCard("8s"), mustCard("6d"), mustCard("4h"), mustCard("3c"), mustCard("2s"),
+			},
+			description: "7-5-4-3-2",
+		},
+		{
+			name: "Ace is high",
+			hand: []Card{
+				mustCard("Ks"), mustCard("5d"), mustCard("4h"), mustCard("3c"), mustCard("2s"),
+			},
+			wantBetter: []Card{
+				mustCard("As"), mustCard("5d"), mustCard("4h"), mustCard("3c"), mustCard("2s"),
+			},
+			description: "King high beats A-5-4-3-2",
+		},
+		{
+			name: "Straight counts against the hand",
+			hand: []Card{
+				mustCard("8s"), mustCard("7d"), mustCard("5h"), mustCard("4c"), mustCard("2s"),
+			},
+			wantBetter: []Card{
+				mustCard("6s"), mustCard("5d"), mustCard("4h"), mustCard("3c"), mustCard("2s"),
+			},
+			description: "8-7-5-4-2 beats a six-high straight",
+		},
+		{
+			name: "Flush counts against the hand",
+			hand: []Card{
+				mustCard("Ks"), mustCard("Qd"), mustCard("Jh"), mustCard("9c"), mustCard("8s"),
+			},
+			wantBetter: []Card{
+				mustCard("7s"), mustCard("5s"), mustCard("4s"), mustCard("3s"), mustCard("2s"),
+			},
+			description: "King high beats a seven-high flush",
+		},
+		{
+			name: "No pair beats a pair",
+			hand: []Card{
+				mustCard("As"), mustCard("Kd"), mustCard("Qh"), mustCard("Jc"), mustCard("9s"),
+			},
+			wantBetter: []Card{
+				mustCard("2s"), mustCard("2d"), mustCard("3h"), mustCard("4c"), mustCard("5s"),
+			},
+			description: "Ace high beats a pair of deuces",
+		},
+		{
+			name: "Lower pair wins",
+			hand: []Card{
+				mustCard("2s"), mustCard("2d"), mustCard("Ah"), mustCard("Kc"), mustCard("Qs"),
+			},
+			wantBetter: []Card{
+				mustCard("3s"), mustCard("3d"), mustCard("4h"), mustCard("5c"), mustCard("6s"),
+			},
+			description: "Pair of deuces beats pair of threes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score1 := Evaluate27Low(tt.hand)
+			score2 := Evaluate27Low(tt.wantBetter)
+			if score1 <= score2 {
+				t.Errorf("%s should beat the other hand: score1=%d, score2=%d", tt.description, score1, score2)
+			}
+		})
+	}
+}
+
+func TestEvaluatePrime(t *testing.T) {
+	tests := []struct {
+		name        string
+		hand        []Card
+		wantBetter  []Card
+		description string
+	}{
+		{
+			name: "More prime cards win",
+			hand: []Card{
+				mustCard("2s"), mustCard("3d"), mustCard("5h"), mustCard("7c"),
+			},
+			wantBetter: []Card{
+				mustCard("Ks"), mustCard("Kd"), mustCard("Jh"), mustCard("Ac"),
+			},
+			description: "Four primes",
+		},
+		{
+			name: "Higher prime total wins",
+			hand: []Card{
+				mustCard("Ks"), mustCard("Jd"), mustCard("7h"), mustCard("4c"),
+			},
+			wantBetter: []Card{
+				mustCard("7s"), mustCard("5d"), mustCard("3h"), mustCard("Ac"),
+			},
+			description: "K-J-7",
+		},
+		{
+			name: "Aces are not prime",
+			hand: []Card{
+				mustCard("2s"), mustCard("4d"), mustCard("6h"), mustCard("8c"),
+			},
+			wantBetter: []Card{
+				mustCard("As"), mustCard("Ad"), mustCard("Ah"), mustCard("Ac"),
+			},
+			description: "A single deuce beats four aces",
+		},
+		{
+			name: "High hand breaks prime ties",
+			hand: []Card{
+				mustCard("Ks"), mustCard("Kd"), mustCard("Qh"), mustCard("9c"),
+			},
+			wantBetter: []Card{
+				mustCard("Ks"), mustCard("Kh"), mustCard("Qd"), mustCard("8c"),
+			},
+			description: "Kings with the better kicker",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score1 := EvaluatePrime(tt.hand)
+			score2 := EvaluatePrime(tt.wantBetter)
+			if score1 <= score2 {
+				t.Errorf("%s should beat the other hand: score1=%d, score2=%d", tt.description, score1, score2)
+			}
+		})
+	}
+}
+
 // Helper function for tests
 func mustCard(s string) Card {
 	c, err := CardFromString(s)
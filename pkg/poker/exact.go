@@ -0,0 +1,80 @@
+package poker
+
+import "context"
+
+// FixedDeal is implemented by games whose deal involves no draws or extra
+// hero cards, so every outcome can be enumerated: the hero plays the 4
+// cards as dealt, each opponent receives holeCards cards and boardCards
+// community cards are dealt (in the order CompleteHand deals them).
+type FixedDeal interface {
+	Game
+	DealSizes() (holeCards, boardCards int)
+}
+
+// MaxExactDeals is the largest number of opponent hand/board combinations
+// ExactEquity enumerates before falling back to Monte‑Carlo
+const MaxExactDeals = 5000000
+
+// ExactEquity returns the hero's heads-up pot share by enumerating every
+// opponent hand and board. Games that draw, or whose deal space exceeds
+// MaxExactDeals, fall back to SimulateEquityResult with DefaultIterations;
+// EquityResult.Exact reports which path was taken. Games that aren't
+// implemented get a result with only Err set.
+func ExactEquity(g Game, my4 []Card) EquityResult {
+	res, _ := Simulator{}.ExactEquity(context.Background(), g, my4)
+	return res
+}
+
+// ExactEquity is the package-level ExactEquity with the fallback simulated
+// on this simulator, so it is dealt from s.Seed. The error is that of
+// Equity; enumeration itself can't fail.
+func (s Simulator) ExactEquity(ctx context.Context, g Game, my4 []Card) (EquityResult, error) {
+	if err := notImplemented(g); err != nil {
+		return EquityResult{Err: err}, err
+	}
+	deck := RemoveCards(FullDeck(), ToSet(my4))
+	if _, ok := exactDeals(g, len(deck)); !ok {
+		return s.Equity(ctx, g, my4, DefaultIterations, 2)
+	}
+	hole, boardSize := g.(FixedDeal).DealSizes()
+
+	var stats shareStats
+	var sd showdown
+	hands := [][]Card{my4, nil}
+	ForEachCombination(deck, hole, func(opp []Card) {
+		hands[1] = opp
+		rest := RemoveCards(deck, ToSet(opp))
+		ForEachCombination(rest, boardSize, func(board []Card) {
+			stats.add(sd.settle(g, hands, board))
+		})
+	})
+	res := stats.result()
+	// Every deal was counted, so there is no sampling error
+	res.StdErr = 0
+	res.Exact = true
+	return res, nil
+}
+
+// exactDeals returns the number of heads-up deals of g from a deck of
+// deckSize cards, and whether ExactEquity can enumerate them
+func exactDeals(g Game, deckSize int) (int, bool) {
+	fd, ok := g.(FixedDeal)
+	if !ok {
+		return 0, false
+	}
+	hole, boardSize := fd.DealSizes()
+	deals := combinations(deckSize, hole) * combinations(deckSize-hole, boardSize)
+	return deals, deals <= MaxExactDeals
+}
+
+// combinations returns n choose k
+func combinations(n, k int) int {
+	if k < 0 || k > n {
+		return 0
+	}
+	c := 1
+	for i := 0; i < k; i++ {
+		c = c * (n - i) / (i + 1)
+	}
+	return c
+}
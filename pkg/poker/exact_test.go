@@ -0,0 +1,135 @@
+package poker
+
+import (
+	"context"
+	"math"
+	mrand "math/rand"
+	"testing"
+)
+
+func TestForEachCombination(t *testing.T) {
+	deck := FullDeck()[:6]
+	for k := 0; k <= 7; k++ {
+		count := 0
+		seen := map[[4]Card]bool{}
+		ForEachCombination(deck, k, func(combo []Card) {
+			count++
+			if k == 4 {
+				var key [4]Card
+				copy(key[:], combo)
+				if seen[key] {
+					t.Errorf("combination %v visited twice", combo)
+				}
+				seen[key] = true
+			}
+		})
+		if want := combinations(6, k); count != want {
+			t.Errorf("k=%d: visited %d combinations, want %d", k, count, want)
+		}
+	}
+}
+
+func TestExactEquity(t *testing.T) {
+	tests := []struct {
+		name      string
+		game      Game
+		hand      []Card
+		wantDeals int
+		minEquity float64
+		maxEquity float64
+	}{
+		{
+			name:      "Perfect badugi",
+			game:      BadugiGame{},
+			hand:      []Card{mustCard("As"), mustCard("2d"), mustCard("3h"), mustCard("4c")},
+			wantDeals: 194580,
+			minEquity: 0.95,
+			maxEquity: 1.0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := ExactEquity(tt.game, tt.hand)
+			if !res.Exact {
+				t.Errorf("Exact = false, want true")
+			}
+			if res.Iterations != tt.wantDeals {
+				t.Errorf("Iterations = %d, want %d", res.Iterations, tt.wantDeals)
+			}
+			if res.Equity < tt.minEquity || res.Equity > tt.maxEquity {
+				t.Errorf("equity = %.4f, want between %.3f and %.3f", res.Equity, tt.minEquity, tt.maxEquity)
+			}
+		})
+	}
+}
+
+func TestExactDeals(t *testing.T) {
+	tests := []struct {
+		game      Game
+		wantDeals int
+		wantExact bool
+	}{
+		{BadugiGame{}, 194580, true},
+		{HiDuGiGame{}, 194580, true},
+		{PrimeGame{}, 194580, true},
+		{OmahaDoubleBoard{}, 0, false},
+		{PLOHi{}, 0, false},
+		{OmahaHiLo8{}, 0, false},
+		{TripleDraw27{}, 0, false},
+		{DrawmahaHi{}, 0, false},
+		{Drawmaha27{}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.game.Name(), func(t *testing.T) {
+			deals, exact := exactDeals(tt.game, 48)
+			if exact != tt.wantExact {
+				t.Errorf("exact = %v, want %v", exact, tt.wantExact)
+			}
+			if tt.wantExact && deals != tt.wantDeals {
+				t.Errorf("deals = %d, want %d", deals, tt.wantDeals)
+			}
+		})
+	}
+}
+
+func TestExactEquityIsReproducible(t *testing.T) {
+	hand := []Card{mustCard("Ks"), mustCard("Qd"), mustCard("5h"), mustCard("2c")}
+	first := ExactEquity(HiDuGiGame{}, hand)
+	second := ExactEquity(HiDuGiGame{}, hand)
+	if first != second {
+		t.Errorf("ExactEquity() is not reproducible: %+v vs %+v", first, second)
+	}
+	// The exact answer must sit inside Monte-Carlo noise of a sampled run
+	sampled := SimulateEquity(HiDuGiGame{}, hand, 20000, 2)
+	if math.Abs(sampled-first.Equity) > 0.02 {
+		t.Errorf("sampled equity %.4f too far from exact %.4f", sampled, first.Equity)
+	}
+}
+
+// sampledBadugi is Badugi without DealSizes, so ExactEquity must sample it
+type sampledBadugi struct{}
+
+func (sampledBadugi) Name() string { return "Sampled Badugi" }
+func (sampledBadugi) CompleteHand(r *mrand.Rand, my []Card, d *Deal) {
+	BadugiGame{}.CompleteHand(r, my, d)
+}
+func (sampledBadugi) Evaluate(dst []int64, h []Card, board []Card) []int64 {
+	return BadugiGame{}.Evaluate(dst, h, board)
+}
+
+func TestSimulatorExactEquitySeed(t *testing.T) {
+	hand := []Card{mustCard("Ks"), mustCard("Qd"), mustCard("5h"), mustCard("2c")}
+	ctx := context.Background()
+	first, _ := Simulator{Seed: 1}.ExactEquity(ctx, sampledBadugi{}, hand)
+	again, _ := Simulator{Seed: 1}.ExactEquity(ctx, sampledBadugi{}, hand)
+	other, _ := Simulator{Seed: 2}.ExactEquity(ctx, sampledBadugi{}, hand)
+	if first.Exact {
+		t.Fatal("a game without DealSizes was enumerated")
+	}
+	if first != again || first.Equity == other.Equity {
+		t.Errorf("fallback equities %.4f, %.4f with seed 1 and %.4f with seed 2; want the seed to decide the deals",
+			first.Equity, again.Equity, other.Equity)
+	}
+}
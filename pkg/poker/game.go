@@ -1,101 +1,270 @@
 package poker
 
+import (
+	mrand "math/rand"
+)
+
 // Game interface defines poker game variants
 type Game interface {
 	Name() string
-	// CompleteHand fills in missing private and public cards for simulation.
-	CompleteHand(my []Card, deck []Card) (myComplete []Card, oppHand []Card, board []Card, deckOut []Card)
-	Evaluate(myComplete []Card, board []Card) int64
+	// CompleteHand fills in missing private and public cards for simulation:
+	// d.Hands[0] becomes the hero's complete hand, every other entry of
+	// d.Hands an opponent's hand, and d.Board the community cards. Cards come
+	// from d.Deck and all randomness from r, so games are safe to share
+	// between goroutines.
+	CompleteHand(r *mrand.Rand, my []Card, d *Deal)
+	// Evaluate appends one score per pot to dst and returns it (higher is
+	// better). Every pot is an equal share of the whole; a NoQualify score
+	// does not contend that pot. Single pot games append a single score.
+	Evaluate(dst []int64, myComplete []Card, board []Card) []int64
 }
 
-// DrawmahaHi implementation
-type DrawmahaHi struct{}
+// DrawmahaHi implementation - split pot Omaha high / 5-card draw high game
+type DrawmahaHi struct {
+	// Strategy decides the discards of every player; nil uses DefaultDrawStrategy
+	Strategy DrawStrategy
+}
 
 func (d DrawmahaHi) Name() string { return "Drawmaha-Hi" }
 
-func (d DrawmahaHi) CompleteHand(my []Card, deck []Card) ([]Card, []Card, []Card, []Card) {
-	// Drawmaha deals 5‑card hands, no community board. Each player keeps 4 original cards & draws 1.
-	var myHand, oppHand []Card
-	var drawn []Card
-	// Draw 1 card for hero
-	drawn, deck = DrawRandom(deck, 1)
-	myHand = append(append([]Card(nil), my...), drawn...)
-	// Opponent 5 cards
-	oppHand, deck = DrawRandom(deck, 5)
-	return myHand, oppHand, nil, deck
+func (d DrawmahaHi) CompleteHand(r *mrand.Rand, my []Card, deal *Deal) {
+	dealDrawmaha(r, my, deal, DrawHigh, d.Strategy)
+}
+
+// Evaluate scores the Omaha half and the high draw half as separate pots.
+func (d DrawmahaHi) Evaluate(dst []int64, h []Card, board []Card) []int64 {
+	omahaScore, highScore := EvaluateDrawmahaHi(h, board)
+	return append(dst, omahaScore, highScore)
+}
+
+// StartingStrength ranks a dealt 5-card hand by its Omaha shape and its
+// strength as a high draw
+func (d DrawmahaHi) StartingStrength(hand []Card) float64 { return drawmahaHiStrength.of(hand) }
+
+// Drawmaha27 implementation - split pot Omaha high / 2-7 lowball draw game
+type Drawmaha27 struct {
+	// Strategy decides the discards of every player; nil uses DefaultDrawStrategy
+	Strategy DrawStrategy
+}
+
+func (d Drawmaha27) Name() string { return "Drawmaha-2-7" }
+
+func (d Drawmaha27) CompleteHand(r *mrand.Rand, my []Card, deal *Deal) {
+	dealDrawmaha(r, my, deal, Draw27Low, d.Strategy)
 }
 
-func (d DrawmahaHi) Evaluate(h []Card, board []Card) int64 {
-	// Already completed to 5 cards
-	return Evaluate5CardHigh(h)
+// Evaluate scores the Omaha half and the 2-7 draw half as separate pots.
+func (d Drawmaha27) Evaluate(dst []int64, h []Card, board []Card) []int64 {
+	omahaScore, lowScore := EvaluateDrawmaha27(h, board)
+	return append(dst, omahaScore, lowScore)
 }
 
+// StartingStrength ranks a dealt 5-card hand by its Omaha shape and its
+// strength as a 2-7 draw
+func (d Drawmaha27) StartingStrength(hand []Card) float64 { return drawmaha27Strength.of(hand) }
+
+// dealDrawmaha plays a Drawmaha hand up to the river: every player holds 5
+// cards (hero keeps 4 originals & is dealt 1), sees the flop, draws once
+// towards goal with the flop passed to the strategy and then sees the turn
+// and river.
+func dealDrawmaha(r *mrand.Rand, my []Card, d *Deal, goal DrawGoal, s DrawStrategy) {
+	if s == nil {
+		s = DefaultDrawStrategy
+	}
+	d.Hands[0] = d.Hand(my, d.Take(r, 1))
+	d.DealOpponents(r, 5)
+	if d.Err() != nil {
+		return
+	}
+	// The turn and river are set aside with the flop so a long draw can't run
+	// the stub out of board cards; nobody sees them before drawing either way.
+	d.Board = d.Take(r, 5)
+
+	for _, h := range d.Hands {
+		Draw(r, h, d.Board[:3], d, goal, s)
+	}
+}
+
+// TripleDraw27 implementation - 2-7 lowball with three draws
+type TripleDraw27 struct {
+	// Strategy decides the discards of every player; nil uses DefaultDrawStrategy
+	Strategy DrawStrategy
+}
+
+func (t TripleDraw27) Name() string { return "2-7 Triple Draw" }
+
+// CompleteHand plays a triple draw hand to showdown: the hero's 4 cards
+// are the first of 5 (hero is dealt 1) and every player draws three times.
+// Discards go to d.Muck, which Draw shuffles back when the deck runs out.
+func (t TripleDraw27) CompleteHand(r *mrand.Rand, my []Card, d *Deal) {
+	s := t.Strategy
+	if s == nil {
+		s = DefaultDrawStrategy
+	}
+	d.Hands[0] = d.Hand(my, d.Take(r, 1))
+	d.DealOpponents(r, 5)
+	if d.Err() != nil {
+		return
+	}
+
+	for round := 0; round < 3; round++ {
+		for _, h := range d.Hands {
+			Draw(r, h, nil, d, Draw27Low, s)
+		}
+	}
+}
+
+func (t TripleDraw27) Evaluate(dst []int64, h []Card, board []Card) []int64 {
+	return append(dst, Lookup27Low(h))
+}
+
+// StartingStrength ranks a dealt 5-card hand by its strength as a 2-7 hand
+func (t TripleDraw27) StartingStrength(hand []Card) float64 { return Draw27Low.Strength(hand) }
+
 // BadugiGame implementation
 type BadugiGame struct{}
 
 func (b BadugiGame) Name() string { return "Badugi" }
 
-func (b BadugiGame) CompleteHand(my []Card, deck []Card) ([]Card, []Card, []Card, []Card) {
+func (b BadugiGame) CompleteHand(r *mrand.Rand, my []Card, d *Deal) {
 	// Badugi uses 4‑card hands; hero already has 4.
-	oppHand, deck := DrawRandom(deck, 4)
-	return my, oppHand, nil, deck
+	d.Hands[0] = my
+	d.DealOpponents(r, 4)
+}
+
+func (b BadugiGame) Evaluate(dst []int64, h []Card, board []Card) []int64 {
+	return append(dst, LookupBadugi(h))
 }
 
-func (b BadugiGame) Evaluate(h []Card, board []Card) int64 { return EvaluateBadugi(h) }
+func (b BadugiGame) DealSizes() (int, int) { return 4, 0 }
+
+// StartingStrength ranks a hand by the badugi it makes
+func (b BadugiGame) StartingStrength(hand []Card) float64 { return badugiStrength.of(hand) }
 
 // HiDuGiGame implementation - split pot Hi/Badugi game
 type HiDuGiGame struct{}
 
 func (h HiDuGiGame) Name() string { return "HiDuGi" }
 
-func (h HiDuGiGame) CompleteHand(my []Card, deck []Card) ([]Card, []Card, []Card, []Card) {
+func (h HiDuGiGame) CompleteHand(r *mrand.Rand, my []Card, d *Deal) {
 	// HiDuGi uses 4-card hands; hero already has 4.
-	oppHand, deck := DrawRandom(deck, 4)
-	return my, oppHand, nil, deck
+	d.Hands[0] = my
+	d.DealOpponents(r, 4)
 }
 
-func (h HiDuGiGame) Evaluate(hand []Card, board []Card) int64 {
+// Evaluate scores the 4-card high half and the badugi half as separate pots.
+func (h HiDuGiGame) Evaluate(dst []int64, hand []Card, board []Card) []int64 {
 	highScore, badugiScore := EvaluateHiDuGi(hand)
+	return append(dst, highScore, badugiScore)
+}
 
-	// Check if we have 8-badugi or better for a strong hand
-	has8Badugi := IsBadugi8OrBetter(hand)
+func (h HiDuGiGame) DealSizes() (int, int) { return 4, 0 }
 
-	// Scoring strategy:
-	// - If we have 8-badugi or better, heavily weight the combined score
-	// - Otherwise, use a balanced approach between high and badugi
+// StartingStrength ranks a hand by the combined strength of its two halves
+func (h HiDuGiGame) StartingStrength(hand []Card) float64 { return hidugiStrength.of(hand) }
 
-	// Get the high hand category (0-8)
-	highCategory := highScore / (13 * 13 * 13 * 13)
+// PrimeGame implementation - 4-card game scored by prime-ranked cards.
+// The scoring in EvaluatePrime is provisional: the house rules of Prime
+// haven't been given yet, so the game is registered as not implemented
+// until they are and EvaluatePrime follows them.
+type PrimeGame struct{}
 
-	// Normalize badugi score
-	normalizedBadugi := badugiScore / 1000000000000
+func (p PrimeGame) Name() string { return "Prime" }
 
-	if has8Badugi {
-		// Strong badugi hands get significant bonus
-		// Add bonus of 10 million to ensure these hands rank highest
-		return highScore + normalizedBadugi + 10000000
-	}
+func (p PrimeGame) CompleteHand(r *mrand.Rand, my []Card, d *Deal) {
+	// Prime uses 4-card hands; hero already has 4.
+	d.Hands[0] = my
+	d.DealOpponents(r, 4)
+}
 
-	// For hands without 8-badugi:
-	// - Strong high hands (category 6+: trips, quads, straight flush) get a boost
-	// - This ensures four aces (category 7) beats most other hands
-	if highCategory >= 6 {
-		// Trips or better get a significant boost
-		return highScore*3 + normalizedBadugi/10
-	}
+func (p PrimeGame) Evaluate(dst []int64, h []Card, board []Card) []int64 {
+	return append(dst, EvaluatePrime(h))
+}
+
+func (p PrimeGame) DealSizes() (int, int) { return 4, 0 }
+
+// StartingStrength ranks a hand by its Prime score
+func (p PrimeGame) StartingStrength(hand []Card) float64 { return primeStrength.of(hand) }
+
+// OmahaDoubleBoard implementation - Omaha high with the pot split between two boards
+type OmahaDoubleBoard struct{}
+
+func (o OmahaDoubleBoard) Name() string { return "Omaha DoubleBoard" }
+
+func (o OmahaDoubleBoard) CompleteHand(r *mrand.Rand, my []Card, d *Deal) {
+	// Omaha uses 4-card hands; hero already has 4. Two 5-card boards are dealt back to back.
+	d.Hands[0] = my
+	d.DealOpponents(r, 4)
+	d.Board = d.Take(r, 10)
+}
+
+// Evaluate scores each of the two boards as a separate pot.
+func (o OmahaDoubleBoard) Evaluate(dst []int64, h []Card, board []Card) []int64 {
+	firstScore, secondScore := EvaluateDoubleBoard(h, board)
+	return append(dst, firstScore, secondScore)
+}
+
+func (o OmahaDoubleBoard) DealSizes() (int, int) { return 4, 10 }
 
-	// For other hands, combine scores with equal weight
-	return highScore + normalizedBadugi/10
+// StartingStrength ranks a hand by a rough Omaha high starting hand count
+func (o OmahaDoubleBoard) StartingStrength(hand []Card) float64 { return omaha4Strength.of(hand) }
+
+// PLOHi implementation - pot limit Omaha high on a single board
+type PLOHi struct{}
+
+func (p PLOHi) Name() string { return "PLO Hi" }
+
+func (p PLOHi) CompleteHand(r *mrand.Rand, my []Card, d *Deal) {
+	// PLO uses 4-card hands; hero already has 4.
+	d.Hands[0] = my
+	d.DealOpponents(r, 4)
+	d.Board = d.Take(r, 5)
+}
+
+func (p PLOHi) Evaluate(dst []int64, h []Card, board []Card) []int64 {
+	return append(dst, BestOmahaHand(h, board))
 }
 
+func (p PLOHi) DealSizes() (int, int) { return 4, 5 }
+
+// StartingStrength ranks a hand by a rough Omaha high starting hand count
+func (p PLOHi) StartingStrength(hand []Card) float64 { return omaha4Strength.of(hand) }
+
+// OmahaHiLo8 implementation - Omaha split between high and an
+// eight-or-better A-5 low
+type OmahaHiLo8 struct{}
+
+func (o OmahaHiLo8) Name() string { return "Omaha Hi-Lo 8" }
+
+func (o OmahaHiLo8) CompleteHand(r *mrand.Rand, my []Card, d *Deal) {
+	// Omaha uses 4-card hands; hero already has 4.
+	d.Hands[0] = my
+	d.DealOpponents(r, 4)
+	d.Board = d.Take(r, 5)
+}
+
+// Evaluate scores the high half and the low half as separate pots. Without
+// a qualifying low nobody contends the low pot, so high takes it all.
+func (o OmahaHiLo8) Evaluate(dst []int64, h []Card, board []Card) []int64 {
+	highScore, lowScore := EvaluateOmahaHiLo8(h, board)
+	return append(dst, highScore, lowScore)
+}
+
+func (o OmahaHiLo8) DealSizes() (int, int) { return 4, 5 }
+
+// StartingStrength ranks a hand by a rough Omaha starting hand count that
+// also rewards low cards
+func (o OmahaHiLo8) StartingStrength(hand []Card) float64 { return omaha8Strength.of(hand) }
+
 // StubGame implementation for unimplemented variants
 type StubGame struct {
 	NameStr string
 }
 
 func (s StubGame) Name() string { return s.NameStr }
-func (s StubGame) CompleteHand(my []Card, deck []Card) ([]Card, []Card, []Card, []Card) {
-	oppHand, deck := DrawRandom(deck, len(my))
-	return my, oppHand, nil, deck
+func (s StubGame) CompleteHand(r *mrand.Rand, my []Card, d *Deal) {
+	d.Hands[0] = my
+	d.DealOpponents(r, len(my))
 }
-func (s StubGame) Evaluate(h []Card, board []Card) int64 { return 0 }
+func (s StubGame) Evaluate(dst []int64, h []Card, board []Card) []int64 { return append(dst, 0) }
+func (s StubGame) DealSizes() (int, int)                                { return 4, 0 }
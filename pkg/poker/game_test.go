@@ -0,0 +1,74 @@
+package poker
+
+import (
+	mrand "math/rand"
+	"testing"
+)
+
+// testRand returns a fixed-seed random source so dealing tests are repeatable
+func testRand() *mrand.Rand {
+	return mrand.New(mrand.NewSource(1))
+}
+
+func TestCompleteHand(t *testing.T) {
+	tests := []struct {
+		game      Game
+		handSize  int
+		boardSize int
+		draws     bool
+	}{
+		{DrawmahaHi{}, 5, 5, true},
+		{Drawmaha27{}, 5, 5, true},
+		{BadugiGame{}, 4, 0, false},
+		{HiDuGiGame{}, 4, 0, false},
+		{PrimeGame{}, 4, 0, false},
+		{OmahaDoubleBoard{}, 4, 10, false},
+		{PLOHi{}, 4, 5, false},
+		{OmahaHiLo8{}, 4, 5, false},
+		{TripleDraw27{}, 5, 0, true},
+	}
+	my := []Card{mustCard("As"), mustCard("Kd"), mustCard("2h"), mustCard("3c")}
+
+	for _, tt := range tests {
+		t.Run(tt.game.Name(), func(t *testing.T) {
+			const opponents = 7
+			d := NewDeal(RemoveCards(FullDeck(), ToSet(my)), opponents+1)
+			tt.game.CompleteHand(testRand(), my, d)
+			myHand, oppHands, board, rest := d.Hands[0], d.Hands[1:], d.Board, d.Deck
+
+			if len(oppHands) != opponents {
+				t.Fatalf("dealt %d opponent hands, want %d", len(oppHands), opponents)
+			}
+			dealt := [][]Card{myHand, board}
+			for _, h := range append([][]Card{myHand}, oppHands...) {
+				if len(h) != tt.handSize {
+					t.Errorf("hand %v has %d cards, want %d", h, len(h), tt.handSize)
+				}
+			}
+			if len(board) != tt.boardSize {
+				t.Errorf("board has %d cards, want %d", len(board), tt.boardSize)
+			}
+			dealt = append(dealt, oppHands...)
+			var seen CardSet
+			total := 0
+			for _, cards := range dealt {
+				for _, c := range cards {
+					if seen.Contains(c) {
+						t.Fatalf("card %s dealt twice", c)
+					}
+					seen = seen.Add(c)
+					total++
+				}
+			}
+			// Draw games muck their discards, so only stud games account for every card
+			if total+len(rest) > 52 || (!tt.draws && total+len(rest) != 52) {
+				t.Errorf("%d cards dealt and %d left, want 52 in total", total, len(rest))
+			}
+			for _, c := range rest {
+				if seen.Contains(c) {
+					t.Fatalf("card %s both dealt and left in the deck", c)
+				}
+			}
+		})
+	}
+}
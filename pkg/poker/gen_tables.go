@@ -0,0 +1,391 @@
+//go:build ignore
+
+// gen_tables builds tables.go, the lookup tables behind the Lookup
+// evaluators, by scoring one representative hand per table entry and
+// numbering the distinct scores. It keeps its own copy of the reference
+// evaluators so it runs without the package whose tables it writes;
+// TestLookupEvaluators keeps the two copies in step.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"slices"
+)
+
+// table is one generated lookup table; entries left unset stay 0
+type table struct {
+	name, doc string
+	scores    []int64
+	set       []bool
+}
+
+func newTable(name, doc string, size int) *table {
+	return &table{name: name, doc: doc, scores: make([]int64, size), set: make([]bool, size)}
+}
+
+func (t *table) put(i int, score int64) {
+	t.scores[i], t.set[i] = score, true
+}
+
+// classify replaces the scores of every table in group by their position
+// among the group's distinct scores, starting at 1
+func classify(group ...*table) {
+	var all []int64
+	for _, t := range group {
+		for i, s := range t.scores {
+			if t.set[i] {
+				all = append(all, s)
+			}
+		}
+	}
+	slices.Sort(all)
+	all = slices.Compact(all)
+	for _, t := range group {
+		for i, s := range t.scores {
+			if t.set[i] {
+				c, _ := slices.BinarySearch(all, s)
+				t.scores[i] = int64(c + 1)
+			}
+		}
+	}
+}
+
+// multisets calls fn with every non-decreasing sequence of k ranks in
+// which no rank appears more than 4 times
+func multisets(k int, fn func(ranks []int)) {
+	ranks := make([]int, 0, k)
+	var rec func(from int)
+	rec = func(from int) {
+		if len(ranks) == k {
+			fn(ranks)
+			return
+		}
+		for r := from; r < 13; r++ {
+			n := len(ranks)
+			if n >= 4 && ranks[n-4] == r {
+				continue
+			}
+			ranks = append(ranks, r)
+			rec(r)
+			ranks = ranks[:n]
+		}
+	}
+	rec(0)
+}
+
+// choose returns n choose k
+func choose(n, k int) int {
+	if k < 0 || k > n {
+		return 0
+	}
+	c := 1
+	for i := 0; i < k; i++ {
+		c = c * (n - i) / (i + 1)
+	}
+	return c
+}
+
+// rankIndex mirrors poker's rankIndex for sorted ranks
+func rankIndex(ranks []int) int {
+	idx := 0
+	for i, r := range ranks {
+		idx += choose(r+i, i+1)
+	}
+	return idx
+}
+
+// hands returns a hand of ranks without a flush (suits cycle, so equal
+// ranks never share a suit) and, when the ranks are distinct, a flush
+func hands(ranks []int) (plain, flush []card) {
+	distinct := true
+	for i, r := range ranks {
+		plain = append(plain, card(r*4+i%4))
+		flush = append(flush, card(r*4))
+		if i > 0 && ranks[i-1] == r {
+			distinct = false
+		}
+	}
+	if !distinct {
+		flush = nil
+	}
+	return plain, flush
+}
+
+// rankTables fills a plain and a flush table of k-card hands for the
+// Lookup function fn from eval
+func rankTables(k int, name, fn string, eval func([]card) int64) (plain, flush *table) {
+	size := choose(12+k, k)
+	plain = newTable(name+"Table", fmt.Sprintf("%sTable holds %s classes of %d-card hands, by rankIndex", name, fn, k), size)
+	flush = newTable(name+"FlushTable", fmt.Sprintf("%sFlushTable holds %s classes of %d-card flushes, by rankIndex", name, fn, k), size)
+	multisets(k, func(ranks []int) {
+		p, f := hands(ranks)
+		plain.put(rankIndex(ranks), eval(p))
+		if f != nil {
+			flush.put(rankIndex(ranks), eval(f))
+		}
+	})
+	classify(plain, flush)
+	return plain, flush
+}
+
+func main() {
+	high5, high5Flush := rankTables(5, "high5", "Lookup5CardHigh", evaluate5CardHigh)
+	low27, low27Flush := rankTables(5, "low27", "Lookup27Low", evaluate27Low)
+	high4, high4Flush := rankTables(4, "high4", "Lookup4CardHigh", evaluate4CardHigh)
+
+	// Suits don't matter in ace-to-five, so one table covers every hand
+	lowA5 := newTable("lowA5Table", "lowA5Table holds LookupA5Low classes of 5-card hands, by rankIndex", choose(17, 5))
+	multisets(5, func(ranks []int) {
+		p, _ := hands(ranks)
+		lowA5.put(rankIndex(ranks), evaluateA5Low(p))
+	})
+	classify(lowA5)
+
+	badugi := newTable("badugiTable", "badugiTable holds LookupBadugi classes of badugis, by rank mask", 1<<13)
+	for mask := 1; mask < 1<<13; mask++ {
+		var hand []card
+		for r := 0; r < 13; r++ {
+			if mask&(1<<r) != 0 {
+				hand = append(hand, card(r*4+len(hand)%4))
+			}
+		}
+		if len(hand) <= 4 {
+			badugi.put(mask, evaluateBadugi(hand))
+		}
+	}
+	classify(badugi)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_tables.go; DO NOT EDIT.\n\npackage poker\n")
+	for _, t := range []*table{high5, high5Flush, low27, low27Flush, lowA5, high4, high4Flush, badugi} {
+		fmt.Fprintf(&buf, "\n// %s\nvar %s = [%d]uint16{", t.doc, t.name, len(t.scores))
+		for i, s := range t.scores {
+			if i%16 == 0 {
+				buf.WriteString("\n")
+			}
+			fmt.Fprintf(&buf, "%d, ", s)
+		}
+		buf.WriteString("\n}\n")
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("tables.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// card mirrors poker.Card: rank*4 + suit
+type card int
+
+func (c card) rank() int { return int(c) / 4 }
+func (c card) suit() int { return int(c) % 4 }
+
+// Hand categories as in poker's evaluator (higher is better)
+const (
+	highCard = iota
+	onePair
+	twoPair
+	trips
+	straight
+	flush
+	fullHouse
+	quads
+	straightFlush
+)
+
+// lowball27Max mirrors poker's constant of the same name
+const lowball27Max = int64(straightFlush+1) * 13 * 13 * 13 * 13 * 13
+
+// counts returns how many cards of each rank and of each suit hand holds
+func counts(hand []card) (ranks []int, suits [4]int) {
+	ranks = make([]int, 13)
+	for _, c := range hand {
+		ranks[c.rank()]++
+		suits[c.suit()]++
+	}
+	return ranks, suits
+}
+
+// groups counts the pairs, trips and quads among rank counts
+func groups(ranks []int) (pairs, trips, quads int) {
+	for _, cnt := range ranks {
+		switch cnt {
+		case 2:
+			pairs++
+		case 3:
+			trips++
+		case 4:
+			quads++
+		}
+	}
+	return pairs, trips, quads
+}
+
+// evaluate5CardHigh mirrors poker.Evaluate5CardHigh
+func evaluate5CardHigh(hand []card) int64 { return evaluate5Card(hand, true) }
+
+// evaluate27Low mirrors poker.Evaluate27Low
+func evaluate27Low(hand []card) int64 { return lowball27Max - evaluate5Card(hand, false) }
+
+// evaluate5Card mirrors poker's evaluate5Card
+func evaluate5Card(hand []card, wheel bool) int64 {
+	ranks, suits := counts(hand)
+	isFlush := slices.Contains(suits[:], 5)
+	top := -1
+	consec := 0
+	for r := 12; r >= 0; r-- {
+		if ranks[r] > 0 {
+			consec++
+			if consec == 5 {
+				top = r + 4
+				break
+			}
+		} else {
+			consec = 0
+		}
+	}
+	if wheel && consec == 4 && ranks[12] > 0 && ranks[3] > 0 && ranks[2] > 0 && ranks[1] > 0 && ranks[0] > 0 {
+		top = 3
+	}
+	isStraight := top != -1
+	pairs, trips3, quads4 := groups(ranks)
+
+	var cat int64
+	switch {
+	case isStraight && isFlush:
+		cat = straightFlush
+	case quads4 > 0:
+		cat = quads
+	case trips3 > 0 && pairs > 0:
+		cat = fullHouse
+	case isFlush:
+		cat = flush
+	case isStraight:
+		cat = straight
+	case trips3 > 0:
+		cat = trips
+	case pairs >= 2:
+		cat = twoPair
+	case pairs > 0:
+		cat = onePair
+	default:
+		cat = highCard
+	}
+	if isStraight {
+		return cat*int64(13*13*13*13*13) + int64(top)
+	}
+	return cat*int64(13*13*13*13*13) + encodeKickers(ranks)
+}
+
+// encodeKickers mirrors poker's encodeKickers
+func encodeKickers(ranks []int) int64 {
+	var kicker int64
+	for cnt := 4; cnt >= 1; cnt-- {
+		for r := 12; r >= 0; r-- {
+			if ranks[r] == cnt {
+				for i := 0; i < cnt; i++ {
+					kicker = kicker*13 + int64(r)
+				}
+			}
+		}
+	}
+	return kicker
+}
+
+// aceLowRank mirrors poker's aceLowRank: ace 0, deuce 1 … king 12
+func aceLowRank(c card) int { return (c.rank() + 1) % 13 }
+
+// evaluateA5Low mirrors poker.EvaluateA5Low
+func evaluateA5Low(hand []card) int64 {
+	ranks := make([]int, 13)
+	for _, c := range hand {
+		ranks[aceLowRank(c)]++
+	}
+	pairs, trips3, quads4 := groups(ranks)
+
+	var cat int64
+	switch {
+	case quads4 > 0:
+		cat = quads
+	case trips3 > 0 && pairs > 0:
+		cat = fullHouse
+	case trips3 > 0:
+		cat = trips
+	case pairs >= 2:
+		cat = twoPair
+	case pairs > 0:
+		cat = onePair
+	default:
+		cat = highCard
+	}
+	return lowball27Max - (cat*int64(13*13*13*13*13) + encodeKickers(ranks))
+}
+
+// evaluateBadugi mirrors poker.EvaluateBadugi for hands whose cards
+// already have distinct ranks and suits, which is all the generator builds
+func evaluateBadugi(hand []card) int64 {
+	low := make([]int, 0, 4)
+	for _, c := range hand {
+		low = append(low, aceLowRank(c))
+	}
+	slices.Sort(low)
+	slices.Reverse(low)
+	var score int64
+	for i := 0; i < 4; i++ {
+		score *= 13
+		if i < len(low) {
+			score += int64(12 - low[i])
+		}
+	}
+	return int64(len(low))*13*13*13*13 + score
+}
+
+// evaluate4CardHigh mirrors poker.Evaluate4CardHigh
+func evaluate4CardHigh(hand []card) int64 {
+	ranks, suits := counts(hand)
+	isFlush := slices.Contains(suits[:], 4)
+	isStraight := false
+	consec := 0
+	for r := 12; r >= 0; r-- {
+		if ranks[r] > 0 {
+			consec++
+			if consec == 4 {
+				isStraight = true
+				break
+			}
+		} else {
+			consec = 0
+		}
+	}
+	if !isStraight && ranks[12] > 0 && ranks[0] > 0 && ranks[1] > 0 && ranks[2] > 0 {
+		isStraight = true
+	}
+	pairs, trips3, quads4 := groups(ranks)
+
+	var cat int64
+	switch {
+	case isStraight && isFlush:
+		cat = 8
+	case quads4 > 0:
+		cat = 7
+	case trips3 > 0:
+		cat = 6
+	case isFlush:
+		cat = 5
+	case isStraight:
+		cat = 4
+	case pairs >= 2:
+		cat = 3
+	case pairs > 0:
+		cat = 2
+	default:
+		cat = 1
+	}
+	return cat*int64(13*13*13*13) + encodeKickers(ranks)
+}
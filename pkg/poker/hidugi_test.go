@@ -1,6 +1,7 @@
 package poker
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -213,55 +214,51 @@ func TestHiDuGiGame(t *testing.T) {
 	game := HiDuGiGame{}
 
 	tests := []struct {
-		name        string
-		hand        []Card
-		description string
+		name       string
+		hand       []Card
+		opp        []Card
+		wantShares []float64
 	}{
 		{
-			name: "Perfect A234 rainbow",
+			name: "Perfect A234 rainbow scoops random cards",
 			hand: []Card{
 				mustCard("As"), mustCard("2d"), mustCard("3h"), mustCard("4c"),
 			},
-			description: "Should have very high score with 8-badugi bonus",
-		},
-		{
-			name: "8-7-6-5 rainbow",
-			hand: []Card{
-				mustCard("8s"), mustCard("7d"), mustCard("6h"), mustCard("5c"),
+			opp: []Card{
+				mustCard("Ks"), mustCard("Qd"), mustCard("9h"), mustCard("7s"),
 			},
-			description: "Should have high score with 8-badugi bonus",
+			wantShares: []float64{1, 0},
 		},
 		{
-			name: "Four aces",
+			name: "Four aces take the high half only",
 			hand: []Card{
 				mustCard("As"), mustCard("Ad"), mustCard("Ah"), mustCard("Ac"),
 			},
-			description: "Strong high but weak badugi, no bonus",
+			opp: []Card{
+				mustCard("8s"), mustCard("7d"), mustCard("6h"), mustCard("5c"),
+			},
+			wantShares: []float64{0.5, 0.5},
 		},
 		{
-			name: "Random cards",
+			name: "Identical ranks chop both halves",
 			hand: []Card{
-				mustCard("Ks"), mustCard("Qd"), mustCard("9h"), mustCard("7c"),
+				mustCard("8s"), mustCard("7d"), mustCard("6h"), mustCard("5c"),
 			},
-			description: "Average hand, no 8-badugi",
+			opp: []Card{
+				mustCard("8d"), mustCard("7s"), mustCard("6c"), mustCard("5h"),
+			},
+			wantShares: []float64{0.5, 0.5},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			score := game.Evaluate(tt.hand, nil)
-			has8Badugi := IsBadugi8OrBetter(tt.hand)
-
-			t.Logf("%s - Score: %d, Has 8-Badugi: %v", tt.description, score, has8Badugi)
-
-			// Check that 8-badugi hands get bonus
-			if has8Badugi && score < 10000000 {
-				t.Errorf("8-badugi hand should get bonus score > 10000000, got %d", score)
+			if pots := len(game.Evaluate(nil, tt.hand, nil)); pots != 2 {
+				t.Fatalf("HiDuGi should have 2 pots, got %d", pots)
 			}
-			// Four aces (trips or better) also get a big boost even without 8-badugi
-			highCategory := score / (13 * 13 * 13 * 13)
-			if !has8Badugi && highCategory < 6 && score >= 10000000 {
-				t.Errorf("Non-8-badugi hand without trips should not get bonus score, got %d", score)
+			shares := Showdown(game, [][]Card{tt.hand, tt.opp}, nil)
+			if !reflect.DeepEqual(shares, tt.wantShares) {
+				t.Errorf("Showdown() = %v, want %v", shares, tt.wantShares)
 			}
 		})
 	}
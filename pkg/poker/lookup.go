@@ -0,0 +1,122 @@
+package poker
+
+import "math/bits"
+
+//go:generate go run gen_tables.go
+
+// The Lookup evaluators score hands from the precomputed tables in
+// tables.go instead of counting ranks on every call. Each returns a hand
+// class: 1 for the worst hand, counting up, with exactly the ordering and
+// ties of the matching Evaluate function. Classes are only comparable with
+// classes from the same Lookup function.
+//
+// Hands without a flush are looked up by their multiset of ranks; flushes,
+// which always have distinct ranks, by the same index in a separate table.
+
+// Lookup5CardHigh is Evaluate5CardHigh from the lookup tables
+func Lookup5CardHigh(hand []Card) int64 {
+	if len(hand) != 5 {
+		panic("lookup5CardHigh expects 5 cards")
+	}
+	if isFlush(hand) {
+		return int64(high5FlushTable[rankIndex(hand)])
+	}
+	return int64(high5Table[rankIndex(hand)])
+}
+
+// Lookup27Low is Evaluate27Low from the lookup tables
+func Lookup27Low(hand []Card) int64 {
+	if len(hand) != 5 {
+		panic("lookup27Low expects 5 cards")
+	}
+	if isFlush(hand) {
+		return int64(low27FlushTable[rankIndex(hand)])
+	}
+	return int64(low27Table[rankIndex(hand)])
+}
+
+// LookupA5Low is EvaluateA5Low from the lookup tables
+func LookupA5Low(hand []Card) int64 {
+	if len(hand) != 5 {
+		panic("lookupA5Low expects 5 cards")
+	}
+	return int64(lowA5Table[rankIndex(hand)])
+}
+
+// Lookup4CardHigh is Evaluate4CardHigh from the lookup tables
+func Lookup4CardHigh(hand []Card) int64 {
+	if len(hand) != 4 {
+		panic("lookup4CardHigh expects 4 cards")
+	}
+	if isFlush(hand) {
+		return int64(high4FlushTable[rankIndex(hand)])
+	}
+	return int64(high4Table[rankIndex(hand)])
+}
+
+// LookupBadugi is EvaluateBadugi from the lookup tables. Every subset of
+// the hand with distinct ranks and suits is tried; the score of such a
+// subset depends only on its ranks, so the table is indexed by rank mask.
+func LookupBadugi(hand []Card) int64 {
+	var best uint16
+	for mask := 1; mask < 1<<len(hand); mask++ {
+		if bits.OnesCount(uint(mask)) > 4 {
+			continue
+		}
+		var ranks, suits uint16
+		ok := true
+		for i, c := range hand {
+			if mask&(1<<i) == 0 {
+				continue
+			}
+			r, s := uint16(1)<<c.Rank(), uint16(1)<<c.Suit()
+			if ranks&r != 0 || suits&s != 0 {
+				ok = false
+				break
+			}
+			ranks |= r
+			suits |= s
+		}
+		if ok && badugiTable[ranks] > best {
+			best = badugiTable[ranks]
+		}
+	}
+	return int64(best)
+}
+
+// isFlush reports whether every card of hand has the same suit
+func isFlush(hand []Card) bool {
+	set := NewCardSet(hand...)
+	return set.SuitCount(hand[0].Suit()) == len(hand)
+}
+
+// choose[n][k] is n choose k for the rank indexes below
+var choose = func() (t [17][6]int) {
+	for n := range t {
+		t[n][0] = 1
+		for k := 1; k < len(t[n]) && k <= n; k++ {
+			t[n][k] = t[n-1][k-1] + t[n-1][k]
+		}
+	}
+	return
+}()
+
+// rankIndex numbers the multiset of ranks in a hand of up to 5 cards: the
+// sorted ranks r0 <= r1 <= … become the distinct values ri+i, ranked in
+// colexicographic order. k-card hands use indexes below C(12+k, k).
+func rankIndex(hand []Card) int {
+	var ranks [5]int
+	for i, c := range hand {
+		r := c.Rank()
+		j := i
+		for ; j > 0 && ranks[j-1] > r; j-- {
+			ranks[j] = ranks[j-1]
+		}
+		ranks[j] = r
+	}
+	idx := 0
+	for i := range hand {
+		idx += choose[ranks[i]+i][i+1]
+	}
+	return idx
+}
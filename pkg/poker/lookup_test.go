@@ -0,0 +1,96 @@
+package poker
+
+import (
+	"slices"
+	"testing"
+)
+
+// checkSameOrder checks that fast ranks every k-card hand exactly as slow
+// does: equal slow scores get equal classes and higher scores higher ones
+func checkSameOrder(t *testing.T, k int, slow, fast func([]Card) int64) {
+	t.Helper()
+	classes := make(map[int64]int64)
+	ForEachCombination(FullDeck(), k, func(hand []Card) {
+		s, f := slow(hand), fast(hand)
+		if c, ok := classes[s]; ok && c != f {
+			t.Fatalf("%v: class %d, but another hand with the same score got %d", hand, f, c)
+		}
+		classes[s] = f
+	})
+
+	scores := make([]int64, 0, len(classes))
+	for s := range classes {
+		scores = append(scores, s)
+	}
+	slices.Sort(scores)
+	for i := 1; i < len(scores); i++ {
+		if classes[scores[i]] <= classes[scores[i-1]] {
+			t.Fatalf("score %d got class %d, not above class %d of lower score %d",
+				scores[i], classes[scores[i]], classes[scores[i-1]], scores[i-1])
+		}
+	}
+	if classes[scores[0]] != 1 {
+		t.Errorf("worst hand has class %d, want 1", classes[scores[0]])
+	}
+}
+
+func TestLookupEvaluators(t *testing.T) {
+	tests := []struct {
+		name       string
+		cards      int
+		slow, fast func([]Card) int64
+	}{
+		{"5-card high", 5, Evaluate5CardHigh, Lookup5CardHigh},
+		{"2-7 low", 5, Evaluate27Low, Lookup27Low},
+		{"A-5 low", 5, EvaluateA5Low, LookupA5Low},
+		{"4-card high", 4, Evaluate4CardHigh, Lookup4CardHigh},
+		{"Badugi", 4, EvaluateBadugi, LookupBadugi},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if testing.Short() && tt.cards == 5 {
+				t.Skip("enumerates every 5-card hand")
+			}
+			checkSameOrder(t, tt.cards, tt.slow, tt.fast)
+		})
+	}
+}
+
+func TestEvaluateA5Low(t *testing.T) {
+	tests := []struct {
+		name        string
+		hand        []Card
+		wantBetter  []Card // hand that should be worse
+		description string
+	}{
+		{
+			name:        "Wheel is the nuts",
+			hand:        []Card{mustCard("5s"), mustCard("4s"), mustCard("3s"), mustCard("2s"), mustCard("As")},
+			wantBetter:  []Card{mustCard("6s"), mustCard("4d"), mustCard("3h"), mustCard("2c"), mustCard("Ad")},
+			description: "A2345 flush beats 6432A",
+		},
+		{
+			name:        "Compared from the highest card down",
+			hand:        []Card{mustCard("8s"), mustCard("5d"), mustCard("4h"), mustCard("3c"), mustCard("2d")},
+			wantBetter:  []Card{mustCard("8s"), mustCard("6d"), mustCard("3h"), mustCard("2c"), mustCard("Ad")},
+			description: "85432 beats 8632A",
+		},
+		{
+			name:        "Any unpaired hand beats a pair",
+			hand:        []Card{mustCard("Ks"), mustCard("Qd"), mustCard("Jh"), mustCard("Tc"), mustCard("8d")},
+			wantBetter:  []Card{mustCard("As"), mustCard("Ad"), mustCard("2h"), mustCard("3c"), mustCard("4d")},
+			description: "KQJT8 beats AA234",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score1 := EvaluateA5Low(tt.hand)
+			score2 := EvaluateA5Low(tt.wantBetter)
+			if score1 <= score2 {
+				t.Errorf("%s should beat the other hand: score1=%d, score2=%d", tt.description, score1, score2)
+			}
+		})
+	}
+}
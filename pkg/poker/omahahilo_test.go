@@ -0,0 +1,101 @@
+package poker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBestOmahaLow8(t *testing.T) {
+	tests := []struct {
+		name  string
+		hole  []Card
+		board []Card
+		want  []Card // the low played, nil for none
+	}{
+		{
+			name:  "Wheel",
+			hole:  []Card{mustCard("3c"), mustCard("4h"), mustCard("Kc"), mustCard("Kh")},
+			board: []Card{mustCard("As"), mustCard("2d"), mustCard("5c"), mustCard("9s"), mustCard("Kd")},
+			want:  []Card{mustCard("3c"), mustCard("4h"), mustCard("As"), mustCard("2d"), mustCard("5c")},
+		},
+		{
+			name:  "Counterfeited",
+			hole:  []Card{mustCard("Ac"), mustCard("2h"), mustCard("7d"), mustCard("Jc")},
+			board: []Card{mustCard("As"), mustCard("2d"), mustCard("5c"), mustCard("9s"), mustCard("Kd")},
+		},
+		{
+			name:  "Two low cards on board",
+			hole:  []Card{mustCard("Ac"), mustCard("2h"), mustCard("3d"), mustCard("4c")},
+			board: []Card{mustCard("5s"), mustCard("8d"), mustCard("9c"), mustCard("Ts"), mustCard("Kd")},
+		},
+		{
+			name:  "Nine is too high",
+			hole:  []Card{mustCard("Ac"), mustCard("9h"), mustCard("Qd"), mustCard("Qc")},
+			board: []Card{mustCard("2s"), mustCard("3d"), mustCard("4c"), mustCard("Ts"), mustCard("Kd")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BestOmahaLow8(tt.hole, tt.board)
+			want := NoQualify
+			if tt.want != nil {
+				want = LookupA5Low(tt.want)
+			}
+			if got != want {
+				t.Errorf("BestOmahaLow8(%v, %v) = %d, want %d", tt.hole, tt.board, got, want)
+			}
+		})
+	}
+}
+
+func TestOmahaHiLo8Showdown(t *testing.T) {
+	tests := []struct {
+		name  string
+		board []Card
+		hands [][]Card
+		want  []float64
+	}{
+		{
+			name:  "No low: high scoops",
+			board: []Card{mustCard("2c"), mustCard("5d"), mustCard("9h"), mustCard("Ts"), mustCard("Kd")},
+			hands: [][]Card{
+				{mustCard("Kc"), mustCard("Kh"), mustCard("3c"), mustCard("4d")},
+				{mustCard("Ac"), mustCard("2d"), mustCard("7c"), mustCard("8d")},
+			},
+			want: []float64{1, 0},
+		},
+		{
+			name:  "High and low split",
+			board: []Card{mustCard("As"), mustCard("2d"), mustCard("5c"), mustCard("9s"), mustCard("Kd")},
+			hands: [][]Card{
+				{mustCard("Kc"), mustCard("Kh"), mustCard("Qc"), mustCard("Jh")},
+				{mustCard("3c"), mustCard("7h"), mustCard("Td"), mustCard("Jc")},
+			},
+			want: []float64{0.5, 0.5},
+		},
+		{
+			name:  "Quartered",
+			board: []Card{mustCard("As"), mustCard("2d"), mustCard("5c"), mustCard("9s"), mustCard("Kd")},
+			hands: [][]Card{
+				{mustCard("Kc"), mustCard("Kh"), mustCard("3c"), mustCard("7d")},
+				{mustCard("3d"), mustCard("7h"), mustCard("Td"), mustCard("Jc")},
+			},
+			want: []float64{0.75, 0.25},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Showdown(OmahaHiLo8{}, tt.hands, tt.board); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Showdown() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSimulateOmahaHiLo8Equity(t *testing.T) {
+	hand := []Card{mustCard("As"), mustCard("Ad"), mustCard("2s"), mustCard("3d")}
+	equity := SimulateEquity(OmahaHiLo8{}, hand, 2000, 2)
+	if equity < 0.6 {
+		t.Errorf("AA23 double suited should be a big favourite: equity = %.3f", equity)
+	}
+}
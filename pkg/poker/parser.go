@@ -7,26 +7,36 @@ import (
 
 // ParseHand parses a hand string into cards
 func ParseHand(arg string) ([]Card, error) {
+	hand, err := ParseCards(arg)
+	if err != nil {
+		return nil, err
+	}
+	if len(hand) != 4 {
+		return nil, fmt.Errorf("need exactly 4 cards, got %d", len(hand))
+	}
+	return hand, nil
+}
+
+// ParseCards parses any number of distinct cards, separated by spaces or
+// commas as in ParseHand. An empty string is no cards.
+func ParseCards(arg string) ([]Card, error) {
 	parts := strings.Fields(arg)
 	if len(parts) == 1 {
 		// maybe comma‑separated "Ac,Kd,2h,3c"
 		parts = strings.Split(arg, ",")
 	}
-	if len(parts) != 4 {
-		return nil, fmt.Errorf("need exactly 4 cards, got %d", len(parts))
-	}
-	hand := make([]Card, 0, 4)
-	seen := map[Card]struct{}{}
+	cards := make([]Card, 0, len(parts))
+	var seen CardSet
 	for _, p := range parts {
 		c, err := CardFromString(strings.TrimSpace(p))
 		if err != nil {
 			return nil, err
 		}
-		if _, dup := seen[c]; dup {
+		if seen.Contains(c) {
 			return nil, fmt.Errorf("duplicate card %s", c)
 		}
-		seen[c] = struct{}{}
-		hand = append(hand, c)
+		seen = seen.Add(c)
+		cards = append(cards, c)
 	}
-	return hand, nil
+	return cards, nil
 }
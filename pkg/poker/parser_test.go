@@ -67,3 +67,31 @@ func TestParseHand(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCards(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []Card
+		wantErr bool
+	}{
+		{name: "Empty", input: "", want: []Card{}},
+		{name: "One card", input: "7h", want: []Card{mustCard("7h")}},
+		{name: "Comma separated", input: "7h,2s,Kd", want: []Card{mustCard("7h"), mustCard("2s"), mustCard("Kd")}},
+		{name: "Duplicate card", input: "7h 7h", wantErr: true},
+		{name: "Invalid card", input: "7h 1s", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCards(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseCards() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseCards() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package poker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBestOmahaHand(t *testing.T) {
+	board := []Card{
+		mustCard("As"), mustCard("Ks"), mustCard("Qs"), mustCard("Js"), mustCard("3h"),
+	}
+	tests := []struct {
+		name          string
+		worse, better []Card
+	}{
+		{
+			name:   "One hole card makes no flush",
+			worse:  []Card{mustCard("9s"), mustCard("4d"), mustCard("5c"), mustCard("6h")},
+			better: []Card{mustCard("2c"), mustCard("2d"), mustCard("7c"), mustCard("8h")},
+		},
+		{
+			name:   "Two hole cards make the flush",
+			worse:  []Card{mustCard("Ah"), mustCard("Ad"), mustCard("4c"), mustCard("5d")},
+			better: []Card{mustCard("2s"), mustCard("3s"), mustCard("7d"), mustCard("8c")},
+		},
+		{
+			name:   "One hole card makes no straight",
+			worse:  []Card{mustCard("Tc"), mustCard("4d"), mustCard("5c"), mustCard("6h")},
+			better: []Card{mustCard("3c"), mustCard("3d"), mustCard("7c"), mustCard("8h")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			worse, better := BestOmahaHand(tt.worse, board), BestOmahaHand(tt.better, board)
+			if worse >= better {
+				t.Errorf("%v scores %d, want below %v's %d", tt.worse, worse, tt.better, better)
+			}
+		})
+	}
+}
+
+func TestPLOHiShowdown(t *testing.T) {
+	board := []Card{
+		mustCard("As"), mustCard("Ks"), mustCard("Qs"), mustCard("Js"), mustCard("3h"),
+	}
+	tests := []struct {
+		name  string
+		hands [][]Card
+		want  []float64
+	}{
+		{
+			name: "Two spades beat one",
+			hands: [][]Card{
+				{mustCard("9s"), mustCard("4d"), mustCard("5c"), mustCard("6h")},
+				{mustCard("2s"), mustCard("3s"), mustCard("7d"), mustCard("8c")},
+			},
+			want: []float64{0, 1},
+		},
+		{
+			name: "Broadway from different hole cards splits",
+			hands: [][]Card{
+				{mustCard("Tc"), mustCard("Kd"), mustCard("5c"), mustCard("6h")},
+				{mustCard("Th"), mustCard("Qd"), mustCard("7c"), mustCard("8h")},
+			},
+			want: []float64{0.5, 0.5},
+		},
+		{
+			name: "Flush over straight over trips",
+			hands: [][]Card{
+				{mustCard("Ah"), mustCard("Ad"), mustCard("4c"), mustCard("5d")},
+				{mustCard("Tc"), mustCard("Kd"), mustCard("5c"), mustCard("6h")},
+				{mustCard("2s"), mustCard("3s"), mustCard("7d"), mustCard("8c")},
+			},
+			want: []float64{0, 0, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Showdown(PLOHi{}, tt.hands, board)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Showdown() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
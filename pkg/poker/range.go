@@ -0,0 +1,324 @@
+package poker
+
+import (
+	"fmt"
+	"math/bits"
+	mrand "math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Range is an opponent model: which starting hands a player plays. Weight
+// returns the probability, between 0 and 1, that a player dealt hand plays
+// it; opponents are dealt from the range by redealing the hands they fold.
+type Range interface {
+	Weight(hand []Card) float64
+}
+
+// AnyHand plays every hand, like an opponent who never folds
+type AnyHand struct{}
+
+func (AnyHand) Weight(hand []Card) float64 { return 1 }
+
+// BadugiRange plays hands that already hold a 4-card badugi no higher than
+// Max (aces low), so Max 7 is "any badugi 9 or better"
+type BadugiRange struct {
+	// Max is the highest card allowed, as a Card.Rank()
+	Max int
+}
+
+func (b BadugiRange) Weight(hand []Card) float64 {
+	var cards [8]Card
+	n := 0
+	for _, c := range hand {
+		if aceLowRank(c) <= b.Max+1 && n < len(cards) {
+			cards[n] = c
+			n++
+		}
+	}
+	for mask := 0; mask < 1<<n; mask++ {
+		if bits.OnesCount(uint(mask)) != 4 {
+			continue
+		}
+		var ranks, suits int
+		for i := 0; i < n; i++ {
+			if mask&(1<<i) != 0 {
+				ranks |= 1 << cards[i].Rank()
+				suits |= 1 << cards[i].Suit()
+			}
+		}
+		if bits.OnesCount(uint(ranks)) == 4 && bits.OnesCount(uint(suits)) == 4 {
+			return 1
+		}
+	}
+	return 0
+}
+
+// HandRanker is implemented by games that can rank the starting hands of
+// their players, which TopRange is based on
+type HandRanker interface {
+	// StartingStrength returns the fraction of starting hands of the same
+	// size that hand, as dealt, ranks above, counting ties as half
+	StartingStrength(hand []Card) float64
+}
+
+// TopRange plays the best Percent percent of starting hands by the game's
+// StartingStrength
+type TopRange struct {
+	Ranker  HandRanker
+	Percent float64
+}
+
+func (t TopRange) Weight(hand []Card) float64 {
+	if t.Ranker.StartingStrength(hand) >= 1-t.Percent/100 {
+		return 1
+	}
+	return 0
+}
+
+// RangePart is one weighted part of a WeightedRange
+type RangePart struct {
+	Range  Range
+	Weight float64
+}
+
+// WeightedRange mixes ranges: a hand is played with the largest weight
+// among the parts it is in, so "badugi 9 or better, top 40% @ 0.5" plays
+// every good badugi and half of the other hands in the top 40%
+type WeightedRange []RangePart
+
+func (w WeightedRange) Weight(hand []Card) float64 {
+	best := 0.0
+	for _, p := range w {
+		best = max(best, p.Weight*p.Range.Weight(hand))
+	}
+	return best
+}
+
+// ParseRange parses a comma-separated opponent range for g. Each part is
+// one of
+//
+//	any                     every hand
+//	top 30%                 the best 30% of starting hands (g must be a HandRanker)
+//	badugi 9 or better      hands holding a 4-card badugi 9-high or lower
+//
+// optionally followed by "@ weight" to play only that fraction of the
+// hands. A top part may end in "of ... hands", as in "top 30% of Omaha
+// hands"; any other trailing words are an error.
+func ParseRange(g Game, spec string) (Range, error) {
+	var parts WeightedRange
+	for _, s := range strings.Split(spec, ",") {
+		weight := 1.0
+		if body, w, ok := strings.Cut(s, "@"); ok {
+			var err error
+			if weight, err = strconv.ParseFloat(strings.TrimSpace(w), 64); err != nil || weight <= 0 || weight > 1 {
+				return nil, fmt.Errorf("invalid range weight %q: must be in (0, 1]", strings.TrimSpace(w))
+			}
+			s = body
+		}
+		r, err := parseRangePart(g, strings.Fields(strings.ToLower(s)))
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, RangePart{Range: r, Weight: weight})
+	}
+	if len(parts) == 1 && parts[0].Weight == 1 {
+		return parts[0].Range, nil
+	}
+	return parts, nil
+}
+
+func parseRangePart(g Game, words []string) (Range, error) {
+	if len(words) > 0 && words[0] == "any" && (len(words) == 1 || len(words) == 2 && isHands(words[1])) {
+		return AnyHand{}, nil
+	}
+	if len(words) > 0 && words[0] == "any" {
+		words = words[1:]
+	}
+	switch {
+	case len(words) == 0:
+		return nil, fmt.Errorf("empty range")
+	case words[0] == "top":
+		if len(words) < 2 {
+			return nil, fmt.Errorf("range %q needs a percentage", strings.Join(words, " "))
+		}
+		pct, err := strconv.ParseFloat(strings.TrimSuffix(words[1], "%"), 64)
+		if err != nil || pct <= 0 || pct > 100 {
+			return nil, fmt.Errorf("invalid range percentage %q", words[1])
+		}
+		if rest := words[2:]; len(rest) > 0 && (len(rest) < 2 || rest[0] != "of" || !isHands(rest[len(rest)-1])) {
+			return nil, unexpectedWords(words, 2)
+		}
+		ranker, ok := g.(HandRanker)
+		if !ok {
+			return nil, fmt.Errorf("%s can't rank starting hands for %q", g.Name(), strings.Join(words, " "))
+		}
+		return TopRange{Ranker: ranker, Percent: pct}, nil
+	case words[0] == "badugi" || words[0] == "badugis":
+		if len(words) == 1 {
+			return BadugiRange{Max: 11}, nil
+		}
+		rank, err := parseRank(words[1])
+		if err != nil {
+			return nil, err
+		}
+		if rank == 12 || rank < 2 {
+			return nil, fmt.Errorf("no 4-card badugi is %s-high", words[1])
+		}
+		if rest := words[2:]; len(rest) > 0 && strings.Join(rest, " ") != "or better" {
+			return nil, unexpectedWords(words, 2)
+		}
+		return BadugiRange{Max: rank}, nil
+	}
+	return nil, fmt.Errorf("unknown range %q", strings.Join(words, " "))
+}
+
+// isHands reports whether word is "hand" or "hands"
+func isHands(word string) bool { return word == "hand" || word == "hands" }
+
+// unexpectedWords is the error for the words of a range part from i on
+func unexpectedWords(words []string, i int) error {
+	return fmt.Errorf("unexpected %q in range %q", strings.Join(words[i:], " "), strings.Join(words, " "))
+}
+
+// parseRank parses a rank such as "9", "T", "10" or "K"
+func parseRank(s string) (int, error) {
+	if s == "10" {
+		s = "t"
+	}
+	for i, c := range rankToChar {
+		if strings.EqualFold(c, s) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid rank %q", s)
+}
+
+// strengthTable turns a starting hand score into the fraction of starting
+// hands of the same size that score lower, ties counting half. 4-card
+// tables count every hand; 5-card tables a fixed sample.
+type strengthTable struct {
+	size  int
+	score func(hand []Card) float64
+
+	once   sync.Once
+	scores []float64 // distinct scores, ascending
+	cum    []float64 // fraction of hands scoring at most scores[i]
+}
+
+func (t *strengthTable) of(hand []Card) float64 {
+	t.once.Do(t.build)
+	s := t.score(hand)
+	i := sort.SearchFloat64s(t.scores, s)
+	below := 0.0
+	if i > 0 {
+		below = t.cum[i-1]
+	}
+	if i < len(t.scores) && t.scores[i] == s {
+		return (below + t.cum[i]) / 2
+	}
+	return below
+}
+
+func (t *strengthTable) build() {
+	type sample struct{ score, weight float64 }
+	var samples []sample
+	if t.size == 4 {
+		for _, c := range HandClasses() {
+			samples = append(samples, sample{t.score(c.Cards), float64(c.Combos)})
+		}
+	} else {
+		// Fixed seed: the table is a property of the game, not of a simulation
+		r := mrand.New(mrand.NewSource(int64(t.size)))
+		deck := FullDeck()
+		for i := 0; i < strengthSamples; i++ {
+			hand, _ := DrawRandom(r, deck, t.size)
+			samples = append(samples, sample{t.score(hand), 1})
+		}
+	}
+	sort.Slice(samples, func(i, j int) bool { return samples[i].score < samples[j].score })
+	total := 0.0
+	for _, s := range samples {
+		total += s.weight
+	}
+	seen := 0.0
+	for i, s := range samples {
+		seen += s.weight
+		if i+1 < len(samples) && samples[i+1].score == s.score {
+			continue
+		}
+		t.scores = append(t.scores, s.score)
+		t.cum = append(t.cum, seen/total)
+	}
+}
+
+// omahaPoints is a rough count of an Omaha high starting hand: every pair
+// of hole cards scores for high cards, pairs, suits and connectedness, and
+// three or more cards of one rank or suit are penalised as dead weight
+func omahaPoints(hand []Card) float64 {
+	pts := 0.0
+	for i := range hand {
+		for j := i + 1; j < len(hand); j++ {
+			a, b := hand[i], hand[j]
+			hi, lo := max(a.Rank(), b.Rank()), min(a.Rank(), b.Rank())
+			pts += float64(hi + lo)
+			switch gap := hi - lo; {
+			case gap == 0:
+				pts += 16 + float64(hi)
+			case gap <= 3:
+				pts += float64(8 - 2*gap)
+			}
+			if a.Suit() == b.Suit() {
+				pts += 6 + float64(hi)/2
+			}
+		}
+	}
+	set := NewCardSet(hand...)
+	for r := 0; r < 13; r++ {
+		if set.RankCount(r) >= 3 {
+			pts -= 30
+		}
+	}
+	for s := 0; s < 4; s++ {
+		if n := set.SuitCount(s); n >= 3 {
+			pts -= 6 * float64(n-2)
+		}
+	}
+	return pts
+}
+
+// omaha8Points is omahaPoints plus a count of the low hands: every pair of
+// hole cards of different ranks eight or lower scores more the lower it is
+func omaha8Points(hand []Card) float64 {
+	pts := omahaPoints(hand)
+	for i := range hand {
+		for j := i + 1; j < len(hand); j++ {
+			a, b := aceLowRank(hand[i]), aceLowRank(hand[j])
+			if a != b && a <= 7 && b <= 7 {
+				pts += float64(2 * (16 - a - b))
+			}
+		}
+	}
+	return pts
+}
+
+// Starting hand strengths of the games that implement HandRanker
+var (
+	badugiStrength = &strengthTable{size: 4, score: func(h []Card) float64 { return float64(LookupBadugi(h)) }}
+	high4Strength  = &strengthTable{size: 4, score: func(h []Card) float64 { return float64(Lookup4CardHigh(h)) }}
+	primeStrength  = &strengthTable{size: 4, score: func(h []Card) float64 { return float64(EvaluatePrime(h)) }}
+	omaha4Strength = &strengthTable{size: 4, score: omahaPoints}
+	omaha5Strength = &strengthTable{size: 5, score: omahaPoints}
+	omaha8Strength = &strengthTable{size: 4, score: omaha8Points}
+	hidugiStrength = &strengthTable{size: 4, score: func(h []Card) float64 {
+		return high4Strength.of(h) + badugiStrength.of(h)
+	}}
+	drawmahaHiStrength = &strengthTable{size: 5, score: func(h []Card) float64 {
+		return omaha5Strength.of(h) + DrawHigh.Strength(h)
+	}}
+	drawmaha27Strength = &strengthTable{size: 5, score: func(h []Card) float64 {
+		return omaha5Strength.of(h) + Draw27Low.Strength(h)
+	}}
+)
@@ -0,0 +1,209 @@
+package poker
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		spec    string
+		game    Game
+		want    Range
+		wantErr bool
+	}{
+		{spec: "any", game: BadugiGame{}, want: AnyHand{}},
+		{spec: "any hand", game: BadugiGame{}, want: AnyHand{}},
+		{spec: "top 25% of hands", game: BadugiGame{}, want: TopRange{Ranker: BadugiGame{}, Percent: 25}},
+		{spec: "any badugi 9 or better", game: BadugiGame{}, want: BadugiRange{Max: 7}},
+		{spec: "Badugi T", game: HiDuGiGame{}, want: BadugiRange{Max: 8}},
+		{spec: "badugi 10 or better", game: HiDuGiGame{}, want: BadugiRange{Max: 8}},
+		{spec: "any badugi", game: BadugiGame{}, want: BadugiRange{Max: 11}},
+		{spec: "top 30% of Omaha hands", game: OmahaDoubleBoard{}, want: TopRange{Ranker: OmahaDoubleBoard{}, Percent: 30}},
+		{
+			spec: "badugi 9 or better, top 40% @ 0.5",
+			game: BadugiGame{},
+			want: WeightedRange{{Range: BadugiRange{Max: 7}, Weight: 1}, {Range: TopRange{Ranker: BadugiGame{}, Percent: 40}, Weight: 0.5}},
+		},
+		{spec: "top 0%", game: BadugiGame{}, wantErr: true},
+		{spec: "top 30%", game: StubGame{"Stub"}, wantErr: true},
+		{spec: "badugi 3", game: BadugiGame{}, wantErr: true},
+		{spec: "badugi A", game: BadugiGame{}, wantErr: true},
+		{spec: "any @ 2", game: BadugiGame{}, wantErr: true},
+		{spec: "suited aces", game: BadugiGame{}, wantErr: true},
+		{spec: "badugi 9 or worse", game: BadugiGame{}, wantErr: true},
+		{spec: "badugi 9 better", game: BadugiGame{}, wantErr: true},
+		{spec: "top 30% garbage", game: BadugiGame{}, wantErr: true},
+		{spec: "top 30% of", game: BadugiGame{}, wantErr: true},
+		{spec: "any hands at all", game: BadugiGame{}, wantErr: true},
+		{spec: "", game: BadugiGame{}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			got, err := ParseRange(tt.game, tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRange(%q) error = %v, wantErr %v", tt.spec, err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseRange(%q) = %#v, want %#v", tt.spec, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBadugiRange(t *testing.T) {
+	tests := []struct {
+		hand string
+		want float64
+	}{
+		{"As 2d 3h 4c", 1},
+		{"9s 2d 3h 4c", 1},
+		{"Ts 2d 3h 4c", 0},
+		{"Ks Qd Jh Tc", 0},
+		{"As 2s 3h 4c", 0}, // three-card badugi
+	}
+	r := BadugiRange{Max: 7}
+	for _, tt := range tests {
+		if got := r.Weight(mustHand(tt.hand)); got != tt.want {
+			t.Errorf("Weight(%s) = %v, want %v", tt.hand, got, tt.want)
+		}
+	}
+	// A Drawmaha hand has five cards to find the badugi in
+	five := mustHand("Ks 2d 3h 4c As")
+	if r.Weight(five) != 1 {
+		t.Errorf("Weight(%v) = 0, want 1", five)
+	}
+}
+
+func TestTopRangeShare(t *testing.T) {
+	// Over every 4-card hand, top 30% should play about 30% of them
+	for _, g := range []HandRanker{BadugiGame{}, HiDuGiGame{}, PrimeGame{}, OmahaDoubleBoard{}} {
+		r := TopRange{Ranker: g, Percent: 30}
+		played, total := 0.0, 0.0
+		for _, c := range HandClasses() {
+			played += r.Weight(c.Cards) * float64(c.Combos)
+			total += float64(c.Combos)
+		}
+		if share := played / total; math.Abs(share-0.3) > 0.05 {
+			t.Errorf("%s: top 30%% plays %.3f of hands", g.(Game).Name(), share)
+		}
+	}
+}
+
+func TestStartingStrength(t *testing.T) {
+	tests := []struct {
+		game          HandRanker
+		better, worse []Card
+	}{
+		{OmahaDoubleBoard{}, mustHand("As Ad Ks Kd"), mustHand("2c 7d 9h Ks")},
+		{PLOHi{}, mustHand("Ks Kd Qs Qd"), mustHand("3c 8d Th 2s")},
+		{OmahaHiLo8{}, mustHand("As Ad 2s 3d"), mustHand("Ks Kd Qs Qd")},
+		{TripleDraw27{}, mustHand("2c 3d 4h 5s 7c"), mustHand("As Ad Ks Kd Kh")},
+		{BadugiGame{}, mustHand("As 2d 3h 4c"), mustHand("As Ad Ks Kd")},
+		{PrimeGame{}, mustHand("Ks Kd Jh 7c"), mustHand("As Ad Qs Qd")},
+		{DrawmahaHi{}, mustHand("As Ad Ks Kd Kh"), mustHand("2c 7d 9h Js 4s")},
+		{Drawmaha27{}, mustHand("2c 3d 4h 5s 7c"), mustHand("As Ad Ks Kd Kh")},
+	}
+	for _, tt := range tests {
+		b, w := tt.game.StartingStrength(tt.better), tt.game.StartingStrength(tt.worse)
+		if b <= w || b < 0 || b > 1 || w < 0 || w > 1 {
+			t.Errorf("%s: strength %v vs %v, want %v ranked above %v in [0, 1]", tt.game.(Game).Name(), b, w, tt.better, tt.worse)
+		}
+	}
+}
+
+func TestDealOpponentsRange(t *testing.T) {
+	tests := []struct {
+		name string
+		rg   Range
+	}{
+		{"badugi 9 or better", BadugiRange{Max: 7}},
+		// Too rare to find by redealing: dealt from the list of hands in range
+		{"badugi 5 or better", BadugiRange{Max: 3}},
+	}
+	hero := mustHand("Ks 2d 3h 4c")
+	live := AllCards.Minus(ToSet(hero)).Cards()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testRand()
+			d := NewDeal(live, 3)
+			d.Range = tt.rg
+			for i := 0; i < 50; i++ {
+				d.Reset(live)
+				BadugiGame{}.CompleteHand(r, hero, d)
+				if err := d.Err(); err != nil {
+					t.Fatal(err)
+				}
+				seen := ToSet(d.Deck)
+				for j, h := range d.Hands {
+					if j > 0 && d.Range.Weight(h) != 1 {
+						t.Fatalf("opponent dealt %v outside the range", h)
+					}
+					if seen.Intersect(ToSet(h)) != 0 {
+						t.Fatalf("card of %v dealt twice", h)
+					}
+					seen = seen.Union(ToSet(h))
+				}
+				if seen != AllCards {
+					t.Fatalf("deal lost cards: %v", AllCards.Minus(seen))
+				}
+			}
+		})
+	}
+}
+
+func TestDealOpponentsEmptyRange(t *testing.T) {
+	// 16 cards five or lower are left, enough for four 5-high badugis at most
+	hero := mustHand("Ac 2c 3c 4c")
+	live := AllCards.Minus(ToSet(hero)).Cards()
+	d := NewDeal(live, 10)
+	d.Range = BadugiRange{Max: 3}
+	BadugiGame{}.CompleteHand(testRand(), hero, d)
+	if !errors.Is(d.Err(), ErrEmptyRange) {
+		t.Fatalf("Err() = %v, want ErrEmptyRange", d.Err())
+	}
+	for _, h := range d.Hands[1:] {
+		if h != nil && d.Range.Weight(h) != 1 {
+			t.Errorf("opponent dealt %v outside the range", h)
+		}
+	}
+}
+
+func TestSimulateEquityRange(t *testing.T) {
+	// A king-high badugi beats most random hands but no badugi 9 or better
+	hand := mustHand("Ks 2d 3h 4c")
+	random := SimulateEquity(BadugiGame{}, hand, 2000, 2)
+	s := Simulator{Ranges: map[string]Range{"Badugi": BadugiRange{Max: 7}}}
+	ranged, err := s.Equity(context.Background(), BadugiGame{}, hand, 2000, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if random < 0.9 || ranged.Equity != 0 {
+		t.Errorf("equity vs random = %.3f, vs badugi 9 or better = %.3f; want > 0.9 and 0", random, ranged.Equity)
+	}
+}
+
+func TestSimulateEquityNarrowRange(t *testing.T) {
+	// A seven-high badugi loses to every badugi 5 or better
+	s := Simulator{Ranges: map[string]Range{"Badugi": BadugiRange{Max: 3}}}
+	res, err := s.Equity(context.Background(), BadugiGame{}, mustHand("2c 3d 4h 7s"), 50, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Equity != 0 {
+		t.Errorf("equity = %.3f, want 0", res.Equity)
+	}
+}
+
+// mustHand parses space-separated cards
+func mustHand(s string) []Card {
+	var hand []Card
+	for _, f := range strings.Fields(s) {
+		hand = append(hand, mustCard(f))
+	}
+	return hand
+}
@@ -0,0 +1,230 @@
+package poker
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// SplitType describes how a game divides the pot
+type SplitType int
+
+const (
+	// SinglePot games award the whole pot to the best hand
+	SinglePot SplitType = iota
+	// SplitHands games split the pot between two kinds of hand, such as
+	// high and badugi
+	SplitHands
+	// SplitBoards games split the pot between community boards
+	SplitBoards
+)
+
+// String returns a short name of the split type
+func (s SplitType) String() string {
+	switch s {
+	case SinglePot:
+		return "single pot"
+	case SplitHands:
+		return "split hands"
+	case SplitBoards:
+		return "split boards"
+	}
+	return fmt.Sprintf("SplitType(%d)", int(s))
+}
+
+// GameInfo is a registered game and what the selector knows about it
+type GameInfo struct {
+	Game Game
+	// Aliases are extra names LookupGame accepts (case-insensitive)
+	Aliases []string
+	// MinPlayers and MaxPlayers bound the table sizes the game is picked for
+	MinPlayers, MaxPlayers int
+	// HoleCards is the number of cards in each player's final hand
+	HoleCards int
+	// Boards is the number of community boards (0 for none)
+	Boards int
+	// Draws is the number of draw rounds (0 for none)
+	Draws int
+	// Split says how the pot is divided
+	Split SplitType
+	// Implemented is false for placeholders whose evaluation isn't real
+	Implemented bool
+	// TieBreak decides exact ties in PickBestGame: the higher value wins,
+	// and equal values go to the game registered first
+	TieBreak int
+}
+
+// Name returns the game's name
+func (i GameInfo) Name() string { return i.Game.Name() }
+
+// Fits reports whether the game can be played by `players` players
+func (i GameInfo) Fits(players int) bool {
+	return players >= i.MinPlayers && players <= i.MaxPlayers
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   []GameInfo
+)
+
+// RegisterGame adds a game to the registry. It fails if the game's name or
+// one of its aliases is already taken.
+func RegisterGame(info GameInfo) error {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	for _, name := range append([]string{info.Name()}, info.Aliases...) {
+		if _, ok := lookupGame(name); ok {
+			return fmt.Errorf("game name %q is already registered", name)
+		}
+	}
+	registry = append(registry, info)
+	return nil
+}
+
+// Games returns every registered game in registration order
+func Games() []GameInfo {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	return append([]GameInfo(nil), registry...)
+}
+
+// LookupGame finds a registered game by name or alias, ignoring case
+func LookupGame(name string) (GameInfo, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	return lookupGame(name)
+}
+
+func lookupGame(name string) (GameInfo, bool) {
+	for _, info := range registry {
+		if strings.EqualFold(info.Name(), name) {
+			return info, true
+		}
+		for _, alias := range info.Aliases {
+			if strings.EqualFold(alias, name) {
+				return info, true
+			}
+		}
+	}
+	return GameInfo{}, false
+}
+
+// ParseGames looks up a comma-separated list of game names or aliases
+func ParseGames(list string) ([]Game, error) {
+	var games []Game
+	for _, name := range strings.Split(list, ",") {
+		info, ok := LookupGame(strings.TrimSpace(name))
+		if !ok {
+			return nil, fmt.Errorf("unknown game %q", strings.TrimSpace(name))
+		}
+		games = append(games, info.Game)
+	}
+	return games, nil
+}
+
+// ErrNotImplemented is returned for games whose evaluation is only a
+// placeholder, so their equity would mean nothing
+var ErrNotImplemented = errors.New("game is not implemented")
+
+// Implemented reports whether g is a real game rather than a placeholder:
+// registered games say so in their GameInfo, and unregistered games are
+// implemented unless they are a StubGame
+func Implemented(g Game) bool {
+	if info, ok := LookupGame(g.Name()); ok {
+		return info.Implemented
+	}
+	_, stub := g.(StubGame)
+	return !stub
+}
+
+// notImplemented returns ErrNotImplemented naming g if g isn't implemented
+func notImplemented(g Game) error {
+	if Implemented(g) {
+		return nil
+	}
+	return fmt.Errorf("%s: %w", g.Name(), ErrNotImplemented)
+}
+
+// unimplementedGames returns the games among games, or among every
+// registered game when games is empty, that aren't implemented
+func unimplementedGames(games []Game) []Game {
+	if len(games) == 0 {
+		for _, info := range Games() {
+			games = append(games, info.Game)
+		}
+	}
+	var out []Game
+	for _, g := range games {
+		if !Implemented(g) {
+			out = append(out, g)
+		}
+	}
+	return out
+}
+
+// selectableGames returns the games PickBestGame chooses from when the
+// caller doesn't name any: every implemented game, in registration order
+func selectableGames() []Game {
+	var games []Game
+	for _, info := range Games() {
+		if info.Implemented {
+			games = append(games, info.Game)
+		}
+	}
+	return games
+}
+
+// tieBreak returns the TieBreak of a game, 0 if it isn't registered
+func tieBreak(g Game) int {
+	info, _ := LookupGame(g.Name())
+	return info.TieBreak
+}
+
+func init() {
+	for _, info := range []GameInfo{
+		{
+			Game: HiDuGiGame{}, Aliases: []string{"hi-dugi"},
+			MinPlayers: 2, MaxPlayers: 8, HoleCards: 4, Split: SplitHands, Implemented: true,
+			// A guaranteed share of a split pot is preferred over an equal single pot
+			TieBreak: 1,
+		},
+		{
+			Game: DrawmahaHi{}, Aliases: []string{"drawmaha", "dmh"},
+			MinPlayers: 2, MaxPlayers: 8, HoleCards: 5, Boards: 1, Draws: 1, Split: SplitHands, Implemented: true,
+		},
+		{
+			Game:       BadugiGame{},
+			MinPlayers: 2, MaxPlayers: 8, HoleCards: 4, Split: SinglePot, Implemented: true,
+		},
+		{
+			Game: Drawmaha27{}, Aliases: []string{"drawmaha27", "dm27"},
+			MinPlayers: 2, MaxPlayers: 8, HoleCards: 5, Boards: 1, Draws: 1, Split: SplitHands, Implemented: true,
+		},
+		{
+			// Provisional rules until the house rules are confirmed
+			Game:       PrimeGame{},
+			MinPlayers: 2, MaxPlayers: 8, HoleCards: 4, Split: SinglePot,
+		},
+		{
+			Game: OmahaDoubleBoard{}, Aliases: []string{"doubleboard", "dbo"},
+			MinPlayers: 2, MaxPlayers: 10, HoleCards: 4, Boards: 2, Split: SplitBoards, Implemented: true,
+		},
+		{
+			Game: PLOHi{}, Aliases: []string{"plo"},
+			MinPlayers: 2, MaxPlayers: 10, HoleCards: 4, Boards: 1, Split: SinglePot, Implemented: true,
+		},
+		{
+			Game: OmahaHiLo8{}, Aliases: []string{"o8", "plo8"},
+			MinPlayers: 2, MaxPlayers: 10, HoleCards: 4, Boards: 1, Split: SplitHands, Implemented: true,
+		},
+		{
+			Game: TripleDraw27{}, Aliases: []string{"27td", "td27"},
+			MinPlayers: 2, MaxPlayers: 6, HoleCards: 5, Draws: 3, Split: SinglePot, Implemented: true,
+		},
+	} {
+		if err := RegisterGame(info); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package poker
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestGames(t *testing.T) {
+	want := []string{
+		"HiDuGi", "Drawmaha-Hi", "Badugi", "Drawmaha-2-7", "Prime", "Omaha DoubleBoard",
+		"PLO Hi", "Omaha Hi-Lo 8", "2-7 Triple Draw",
+	}
+	games := Games()
+	if len(games) != len(want) {
+		t.Fatalf("Games() returned %d games, want %d", len(games), len(want))
+	}
+	for i, info := range games {
+		if info.Name() != want[i] {
+			t.Errorf("Games()[%d] = %s, want %s", i, info.Name(), want[i])
+		}
+	}
+	// Prime's rules are provisional, so it must not be picked yet
+	if Implemented(PrimeGame{}) {
+		t.Error("Prime is implemented before its rules are confirmed")
+	}
+}
+
+func TestLookupGame(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+		ok   bool
+	}{
+		{"Badugi", "Badugi", true},
+		{"badugi", "Badugi", true},
+		{"DMH", "Drawmaha-Hi", true},
+		{"drawmaha27", "Drawmaha-2-7", true},
+		{"dbo", "Omaha DoubleBoard", true},
+		{"PLO", "PLO Hi", true},
+		{"o8", "Omaha Hi-Lo 8", true},
+		{"27TD", "2-7 Triple Draw", true},
+		{"Razz", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, ok := LookupGame(tt.name)
+			if ok != tt.ok {
+				t.Fatalf("LookupGame(%q) ok = %v, want %v", tt.name, ok, tt.ok)
+			}
+			if ok && info.Name() != tt.want {
+				t.Errorf("LookupGame(%q) = %s, want %s", tt.name, info.Name(), tt.want)
+			}
+		})
+	}
+}
+
+func TestRegisterGameDuplicate(t *testing.T) {
+	tests := []struct {
+		name string
+		info GameInfo
+	}{
+		{"same name", GameInfo{Game: BadugiGame{}}},
+		{"name taken by alias", GameInfo{Game: StubGame{NameStr: "DMH"}}},
+		{"alias taken by name", GameInfo{Game: StubGame{NameStr: "Razz"}, Aliases: []string{"prime"}}},
+	}
+	before := len(Games())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := RegisterGame(tt.info); err == nil {
+				t.Error("RegisterGame succeeded, want an error")
+			}
+		})
+	}
+	if got := len(Games()); got != before {
+		t.Errorf("registry grew from %d to %d games", before, got)
+	}
+}
+
+func TestParseGames(t *testing.T) {
+	games, err := ParseGames("badugi, Prime,dbo")
+	if err != nil {
+		t.Fatalf("ParseGames: %v", err)
+	}
+	want := []string{"Badugi", "Prime", "Omaha DoubleBoard"}
+	for i, g := range games {
+		if g.Name() != want[i] {
+			t.Errorf("game %d = %s, want %s", i, g.Name(), want[i])
+		}
+	}
+	if _, err := ParseGames("badugi,razz"); err == nil {
+		t.Error("ParseGames accepted an unknown game")
+	}
+}
+
+func TestPickBestGameSubset(t *testing.T) {
+	// Four aces want Drawmaha-Hi from the full list, so the subset must be honoured
+	hand := []Card{mustCard("As"), mustCard("Ad"), mustCard("Ah"), mustCard("Ac")}
+	sel := PickBestGame(hand, 2000, 2, BadugiGame{}, HiDuGiGame{})
+	if sel.Best.Name() != "HiDuGi" {
+		t.Errorf("best = %s, want HiDuGi", sel.Best.Name())
+	}
+	if len(sel.Results) != 2 {
+		t.Errorf("got results for %d games, want 2", len(sel.Results))
+	}
+}
+
+func TestCandidateGamesPlayers(t *testing.T) {
+	games := candidateGames(nil, 10, 0)
+	want := []string{"Omaha DoubleBoard", "PLO Hi", "Omaha Hi-Lo 8"}
+	if len(games) != len(want) {
+		t.Fatalf("candidateGames at 10 players = %v, want %v", games, want)
+	}
+	for i, g := range games {
+		if g.Name() != want[i] {
+			t.Errorf("candidateGames at 10 players = %v, want %v", games, want)
+			break
+		}
+	}
+
+	// The games left out are listed with the reason
+	sel, _ := pickBest(nil, 10, 0, RiskNeutral, func(Game) (EquityResult, error) { return EquityResult{}, nil })
+	if len(sel.Results) != len(Games()) {
+		t.Fatalf("got %d results, want one per game", len(sel.Results))
+	}
+	if r, _ := sel.Result("Badugi"); !errors.Is(r.Err, ErrTableSize) {
+		t.Errorf("Badugi at 10 players: Err = %v, want ErrTableSize", r.Err)
+	}
+}
+
+func TestPickBestTieBreak(t *testing.T) {
+	even := func(Game) (EquityResult, error) { return EquityResult{Equity: 0.5}, nil }
+	tests := []struct {
+		name  string
+		games []Game
+		want  string
+	}{
+		{"higher TieBreak wins", []Game{BadugiGame{}, HiDuGiGame{}}, "HiDuGi"},
+		{"equal TieBreak keeps order", []Game{OmahaDoubleBoard{}, BadugiGame{}}, "Omaha DoubleBoard"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sel, _ := pickBest(tt.games, 2, 0, RiskNeutral, even)
+			if sel.Best.Name() != tt.want {
+				t.Errorf("best = %s, want %s", sel.Best.Name(), tt.want)
+			}
+			if sel.Margin != 0 {
+				t.Errorf("margin = %v, want 0", sel.Margin)
+			}
+		})
+	}
+}
+
+func TestNotImplemented(t *testing.T) {
+	hand := []Card{mustCard("As"), mustCard("Kd"), mustCard("2h"), mustCard("3c")}
+	stub := StubGame{"Stub"}
+	if Implemented(stub) {
+		t.Error("unregistered StubGame reported as implemented")
+	}
+
+	res, err := Simulator{}.Equity(context.Background(), stub, hand, 1000, 2)
+	if !errors.Is(err, ErrNotImplemented) || !errors.Is(res.Err, ErrNotImplemented) {
+		t.Errorf("Equity error = %v, result error = %v, want ErrNotImplemented", err, res.Err)
+	}
+	if res.Iterations != 0 || res.Equity != 0 {
+		t.Errorf("Equity dealt %d deals with equity %.3f, want none", res.Iterations, res.Equity)
+	}
+	if res := ExactEquity(stub, hand); !errors.Is(res.Err, ErrNotImplemented) {
+		t.Errorf("ExactEquity error = %v, want ErrNotImplemented", res.Err)
+	}
+
+	// A placeholder must not win even when every real game is below an even share
+	sel := PickBestGame(hand, 1000, 8, stub, BadugiGame{})
+	if sel.Best == nil || sel.Best.Name() != "Badugi" {
+		t.Errorf("best = %v, want Badugi", sel.Best)
+	}
+	if _, ok := sel.Equities()["Stub"]; ok {
+		t.Error("placeholder has an equity")
+	}
+	if r := sel.Results[len(sel.Results)-1]; r.Name() != "Stub" || !errors.Is(r.Err, ErrNotImplemented) {
+		t.Errorf("last result = %s with error %v, want Stub with ErrNotImplemented", r.Name(), r.Err)
+	}
+
+	sel = PickBestGameAdaptive(hand, AdaptiveOptions{Games: []Game{stub, BadugiGame{}}, BatchSize: 500, MaxIterations: 500})
+	if r, _ := sel.Result("Stub"); !errors.Is(r.Err, ErrNotImplemented) {
+		t.Errorf("adaptive result error = %v, want ErrNotImplemented", r.Err)
+	}
+}
+
+func TestRegisteredPlaceholder(t *testing.T) {
+	saved := Games()
+	t.Cleanup(func() {
+		registryMu.Lock()
+		registry = saved
+		registryMu.Unlock()
+	})
+	// A registered game is a placeholder unless its GameInfo says otherwise,
+	// whatever its type
+	registryMu.Lock()
+	registry = nil
+	registryMu.Unlock()
+	for _, info := range []GameInfo{
+		{Game: BadugiGame{}, MinPlayers: 2, MaxPlayers: 8},
+		{Game: OmahaDoubleBoard{}, MinPlayers: 2, MaxPlayers: 10, Implemented: true},
+	} {
+		if err := RegisterGame(info); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if Implemented(BadugiGame{}) {
+		t.Error("registered placeholder reported as implemented")
+	}
+	if !Implemented(OmahaDoubleBoard{}) {
+		t.Error("registered game reported as a placeholder")
+	}
+	// With no games given, every registered game is checked
+	got := unimplementedGames(nil)
+	if len(got) != 1 || got[0].Name() != "Badugi" {
+		t.Errorf("unimplementedGames(nil) = %v, want [Badugi]", got)
+	}
+	if got := candidateGames(nil, 2, 0); len(got) != 1 || got[0].Name() != "Omaha DoubleBoard" {
+		t.Errorf("candidateGames(nil) = %v, want [Omaha DoubleBoard]", got)
+	}
+}
@@ -0,0 +1,24 @@
+package poker
+
+// RiskPreference turns an EquityResult into the score PickBestGameWithRisk
+// ranks games by. The zero value is risk neutral: games are ranked purely by
+// expected pot share.
+type RiskPreference struct {
+	// VarianceWeight is subtracted per unit of pot share variance
+	VarianceWeight float64
+	// HalfPotWeight is added per unit of probability of taking at least half
+	// the pot, rewarding games where a hand locks up one side of a split pot
+	HalfPotWeight float64
+}
+
+// RiskNeutral ranks games by equity alone
+var RiskNeutral = RiskPreference{}
+
+// RiskAverse prefers steadier games: a game must beat a safer one by more than
+// the difference in its spread and in its chance of securing half the pot
+var RiskAverse = RiskPreference{VarianceWeight: 0.5, HalfPotWeight: 0.1}
+
+// Score returns the risk adjusted value of a simulation result
+func (r RiskPreference) Score(res EquityResult) float64 {
+	return res.Equity - r.VarianceWeight*res.Variance + r.HalfPotWeight*res.AtLeastHalf
+}
@@ -0,0 +1,128 @@
+package poker
+
+import (
+	"slices"
+	"time"
+)
+
+// GameResult is one game's line in a Selection
+type GameResult struct {
+	Game Game
+	EquityResult
+	// Score is the risk adjusted value the games were ranked by
+	Score float64
+	// Duration is the time spent evaluating the game
+	Duration time.Duration
+}
+
+// Name returns the game's name
+func (r GameResult) Name() string { return r.Game.Name() }
+
+// Selection is the outcome of choosing a game for a hand: every game
+// considered, ranked, and the recommendation
+type Selection struct {
+	// Results holds every game considered, best first. Games that couldn't
+	// be evaluated (Err set) come last.
+	Results []GameResult
+	// Best is the recommended game, nil if no game could be evaluated
+	Best Game
+	// Margin is the score of Best less that of the runner-up (0 without one)
+	Margin float64
+}
+
+// Result returns the result of the game called name
+func (s Selection) Result(name string) (GameResult, bool) {
+	for _, r := range s.Results {
+		if r.Name() == name {
+			return r, true
+		}
+	}
+	return GameResult{}, false
+}
+
+// Equities returns the equity of every game that was evaluated, by name
+func (s Selection) Equities() map[string]float64 {
+	equities := make(map[string]float64, len(s.Results))
+	for _, r := range s.Results {
+		if r.Err == nil {
+			equities[r.Name()] = r.Equity
+		}
+	}
+	return equities
+}
+
+// newSelection ranks results best first, ties broken as by beats and then
+// by the order given, and fills in the recommendation
+func newSelection(results []GameResult) Selection {
+	slices.SortStableFunc(results, func(a, b GameResult) int {
+		switch {
+		case a.Err == nil && b.Err != nil:
+			return -1
+		case a.Err != nil && b.Err == nil:
+			return 1
+		case a.Err != nil:
+			return 0
+		case beats(a.Game, a.Score, b.Game, b.Score):
+			return -1
+		case beats(b.Game, b.Score, a.Game, a.Score):
+			return 1
+		}
+		return 0
+	})
+	s := Selection{Results: results}
+	if len(results) > 0 && results[0].Err == nil {
+		s.Best = results[0].Game
+		if len(results) > 1 && results[1].Err == nil {
+			s.Margin = results[0].Score - results[1].Score
+		}
+	}
+	return s
+}
+
+// pickBest evaluates with equity the games that can be chosen at a table
+// of `players` with the dead cards out of the deck and ranks them by
+// risk.Score. Games left out are listed with the reason (see
+// appendSkipped). On error the games evaluated so far are returned.
+func pickBest(games []Game, players int, dead CardSet, risk RiskPreference, equity func(Game) (EquityResult, error)) (Selection, error) {
+	var results []GameResult
+	for _, g := range candidateGames(games, players, dead) {
+		start := time.Now()
+		res, err := equity(g)
+		if err != nil {
+			return newSelection(results), err
+		}
+		results = append(results, GameResult{Game: g, EquityResult: res, Score: risk.Score(res), Duration: time.Since(start)})
+	}
+	results = appendSkipped(results, games, players, dead)
+	return newSelection(results), nil
+}
+
+// appendSkipped adds a result carrying the reason for every game that
+// candidateGames leaves out of games: ErrNotImplemented for every game of
+// games (every registered game if empty) that isn't implemented, then
+// ErrTableSize or ErrShortDeck for the implemented games unfit for the
+// table
+func appendSkipped(results []GameResult, games []Game, players int, dead CardSet) []GameResult {
+	for _, g := range unimplementedGames(games) {
+		results = append(results, GameResult{Game: g, EquityResult: EquityResult{Err: notImplemented(g)}})
+	}
+	if len(games) == 0 {
+		games = selectableGames()
+	}
+	for _, g := range games {
+		if err := unfit(g, players, dead); Implemented(g) && err != nil {
+			results = append(results, GameResult{Game: g, EquityResult: EquityResult{Err: err}})
+		}
+	}
+	return results
+}
+
+// beats reports whether game a with score sa ranks above game b with score
+// sb: the higher score wins and exact ties go to the higher TieBreak. On a
+// complete tie the game already leading stays in front.
+func beats(a Game, sa float64, b Game, sb float64) bool {
+	if sa != sb {
+		return sa > sb
+	}
+	return tieBreak(a) > tieBreak(b)
+}
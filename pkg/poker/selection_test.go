@@ -0,0 +1,78 @@
+package poker
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestShareStatsBreakdown(t *testing.T) {
+	tests := []struct {
+		name   string
+		shares []float64
+		want   EquityResult
+	}{
+		{"scoop", []float64{1, 0, 0}, EquityResult{Win: 1, Scoop: 1}},
+		{"loss", []float64{0, 1, 0}, EquityResult{Loss: 1}},
+		{"half of a split pot", []float64{0.5, 0.5, 0}, EquityResult{Tie: 1, Split: 1}},
+		{"bigger half", []float64{0.75, 0.25}, EquityResult{Win: 1, Split: 1}},
+		{"smaller half", []float64{0.25, 0.75}, EquityResult{Loss: 1, Split: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s shareStats
+			s.add(tt.shares)
+			got := s.result()
+			if got.Win != tt.want.Win || got.Tie != tt.want.Tie || got.Loss != tt.want.Loss ||
+				got.Scoop != tt.want.Scoop || got.Split != tt.want.Split {
+				t.Errorf("win/tie/loss/scoop/split = %v/%v/%v/%v/%v, want %v/%v/%v/%v/%v",
+					got.Win, got.Tie, got.Loss, got.Scoop, got.Split,
+					tt.want.Win, tt.want.Tie, tt.want.Loss, tt.want.Scoop, tt.want.Split)
+			}
+		})
+	}
+}
+
+func TestPickBestGameSelection(t *testing.T) {
+	hand := []Card{mustCard("2s"), mustCard("3d"), mustCard("4h"), mustCard("5c")}
+	sel := PickBestGame(hand, 2000, 2, OmahaDoubleBoard{}, BadugiGame{}, StubGame{"Stub"}, HiDuGiGame{})
+	if len(sel.Results) != 4 {
+		t.Fatalf("got %d results, want 4", len(sel.Results))
+	}
+	if sel.Best == nil || sel.Best.Name() != sel.Results[0].Name() {
+		t.Fatalf("best = %v, want the first result %s", sel.Best, sel.Results[0].Name())
+	}
+	for i, r := range sel.Results[:3] {
+		if r.Err != nil {
+			t.Errorf("%s: unexpected error %v", r.Name(), r.Err)
+		}
+		if i > 0 && r.Score > sel.Results[i-1].Score {
+			t.Errorf("%s ranked below %s with a higher score", r.Name(), sel.Results[i-1].Name())
+		}
+		if r.Iterations != 2000 || r.Duration <= 0 {
+			t.Errorf("%s: %d deals in %v, want 2000 timed deals", r.Name(), r.Iterations, r.Duration)
+		}
+		if sum := r.Win + r.Tie + r.Loss; math.Abs(sum-1) > 1e-9 {
+			t.Errorf("%s: win+tie+loss = %v, want 1", r.Name(), sum)
+		}
+		if r.Scoop+r.Split > 1+1e-9 {
+			t.Errorf("%s: scoop+split = %v, want at most 1", r.Name(), r.Scoop+r.Split)
+		}
+	}
+	if r := sel.Results[3]; r.Name() != "Stub" || !errors.Is(r.Err, ErrNotImplemented) {
+		t.Errorf("last result = %s with error %v, want Stub with ErrNotImplemented", r.Name(), r.Err)
+	}
+	if want := sel.Results[0].Score - sel.Results[1].Score; sel.Margin != want {
+		t.Errorf("margin = %v, want %v", sel.Margin, want)
+	}
+}
+
+func TestSelectionNothingEvaluated(t *testing.T) {
+	sel := PickBestGame([]Card{mustCard("As"), mustCard("Kd"), mustCard("2h"), mustCard("3c")}, 100, 2, StubGame{"Stub"})
+	if sel.Best != nil || sel.Margin != 0 {
+		t.Errorf("best = %v, margin = %v, want no recommendation", sel.Best, sel.Margin)
+	}
+	if len(sel.Equities()) != 0 {
+		t.Errorf("got equities %v, want none", sel.Equities())
+	}
+}
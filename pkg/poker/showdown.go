@@ -0,0 +1,80 @@
+package poker
+
+import (
+	"math"
+)
+
+// NoQualify is the pot score of a hand that does not contend that pot
+// (for example a hand without a qualifying low).
+const NoQualify int64 = math.MinInt64
+
+// Showdown evaluates every hand and returns each player's share of the pot.
+// Each pot returned by Evaluate is worth an equal share, the best score takes
+// it and ties split it. A pot nobody qualifies for is folded into the pots
+// that were contested, so the shares always sum to 1.
+func Showdown(g Game, hands [][]Card, board []Card) []float64 {
+	var s showdown
+	return s.settle(g, hands, board)
+}
+
+// showdown holds the buffers of Showdown so simulations can settle hand
+// after hand without allocating
+type showdown struct {
+	scores []int64 // every player's pot scores, one player after another
+	best   []int64
+	shares []float64
+}
+
+// settle is Showdown reusing s's buffers; the result is valid until the next call
+func (s *showdown) settle(g Game, hands [][]Card, board []Card) []float64 {
+	s.scores = s.scores[:0]
+	for _, h := range hands {
+		s.scores = g.Evaluate(s.scores, h, board)
+	}
+	pots := len(s.scores) / len(hands)
+	score := func(i, p int) int64 { return s.scores[i*pots+p] }
+
+	s.best = s.best[:0]
+	contested := 0
+	for p := 0; p < pots; p++ {
+		best := NoQualify
+		for i := range hands {
+			best = max(best, score(i, p))
+		}
+		s.best = append(s.best, best)
+		if best != NoQualify {
+			contested++
+		}
+	}
+
+	if cap(s.shares) < len(hands) {
+		s.shares = make([]float64, len(hands))
+	}
+	s.shares = s.shares[:len(hands)]
+	clear(s.shares)
+	if contested == 0 {
+		// Nobody qualifies for anything: chop it
+		for i := range s.shares {
+			s.shares[i] = 1.0 / float64(len(hands))
+		}
+		return s.shares
+	}
+	potSize := 1.0 / float64(contested)
+	for p, best := range s.best {
+		if best == NoQualify {
+			continue
+		}
+		winners := 0
+		for i := range hands {
+			if score(i, p) == best {
+				winners++
+			}
+		}
+		for i := range hands {
+			if score(i, p) == best {
+				s.shares[i] += potSize / float64(winners)
+			}
+		}
+	}
+	return s.shares
+}
@@ -0,0 +1,80 @@
+package poker
+
+import (
+	mrand "math/rand"
+	"reflect"
+	"testing"
+)
+
+// fixedGame scores each hand by looking up its first card
+type fixedGame struct {
+	scores map[Card][]int64
+}
+
+func (f fixedGame) Name() string                                   { return "Fixed" }
+func (f fixedGame) CompleteHand(r *mrand.Rand, my []Card, d *Deal) { d.Hands[0] = my }
+func (f fixedGame) Evaluate(dst []int64, h []Card, board []Card) []int64 {
+	return append(dst, f.scores[h[0]]...)
+}
+
+func TestShowdown(t *testing.T) {
+	a, b, c := mustCard("As"), mustCard("Ks"), mustCard("Qs")
+	tests := []struct {
+		name   string
+		scores map[Card][]int64
+		hands  [][]Card
+		want   []float64
+	}{
+		{
+			name:   "Single pot winner",
+			scores: map[Card][]int64{a: {5}, b: {3}},
+			hands:  [][]Card{{a}, {b}},
+			want:   []float64{1, 0},
+		},
+		{
+			name:   "Single pot tie",
+			scores: map[Card][]int64{a: {5}, b: {5}, c: {1}},
+			hands:  [][]Card{{a}, {b}, {c}},
+			want:   []float64{0.5, 0.5, 0},
+		},
+		{
+			name:   "Split pot scoop",
+			scores: map[Card][]int64{a: {5, 5}, b: {3, 3}},
+			hands:  [][]Card{{a}, {b}},
+			want:   []float64{1, 0},
+		},
+		{
+			name:   "Split pot halves",
+			scores: map[Card][]int64{a: {5, 1}, b: {3, 3}},
+			hands:  [][]Card{{a}, {b}},
+			want:   []float64{0.5, 0.5},
+		},
+		{
+			name:   "Quartered",
+			scores: map[Card][]int64{a: {5, 3}, b: {5, 1}},
+			hands:  [][]Card{{a}, {b}},
+			want:   []float64{0.75, 0.25},
+		},
+		{
+			name:   "Unqualified pot goes to the other pot",
+			scores: map[Card][]int64{a: {5, NoQualify}, b: {3, NoQualify}},
+			hands:  [][]Card{{a}, {b}},
+			want:   []float64{1, 0},
+		},
+		{
+			name:   "Only one hand qualifies",
+			scores: map[Card][]int64{a: {5, NoQualify}, b: {3, 1}},
+			hands:  [][]Card{{a}, {b}},
+			want:   []float64{0.5, 0.5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Showdown(fixedGame{tt.scores}, tt.hands, nil)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Showdown() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,44 +1,384 @@
 package poker
 
-// SimulateEquity returns hero win probability against one opponent by Monte‑Carlo
-func SimulateEquity(g Game, my4 []Card, iters int) float64 {
-	// Special handling for HiDuGi as a split pot game
-	if _, ok := g.(HiDuGiGame); ok {
-		return SimulateHiDuGiEquity(my4, iters)
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	mrand "math/rand"
+	"runtime"
+	"sync"
+)
+
+// DefaultIterations is the number of Monte‑Carlo deals used when the caller
+// doesn't choose one
+const DefaultIterations = 100000
+
+// EquityResult summarises the hero's pot share over a simulation
+type EquityResult struct {
+	// Equity is the expected share of the pot (0..1)
+	Equity float64
+	// StdErr is the standard error of Equity (0 for exact results)
+	StdErr float64
+	// Variance is the variance of the per-hand pot share
+	Variance float64
+	// AtLeastHalf is the probability of taking half the pot or more
+	AtLeastHalf float64
+	// Win, Tie and Loss are the probabilities of taking more of the pot
+	// than every opponent, as much as the best of them, or less; they sum
+	// to 1
+	Win, Tie, Loss float64
+	// Scoop is the probability of taking the whole pot and Split of
+	// sharing it with at least one opponent
+	Scoop, Split float64
+	// Iterations is the number of simulated (or enumerated) deals
+	Iterations int
+	// Exact is set when every deal was enumerated instead of sampled
+	Exact bool
+	// Err is set, wrapping ErrNotImplemented, for games that couldn't be
+	// evaluated; every other field is then zero
+	Err error
+}
+
+// CI95 returns the 95% confidence interval of Equity
+func (r EquityResult) CI95() (low, high float64) {
+	return r.Equity - 1.96*r.StdErr, r.Equity + 1.96*r.StdErr
+}
+
+// shareStats accumulates per-deal pot shares into an EquityResult
+type shareStats struct {
+	n                          int
+	sum, sumSq                 float64
+	atLeastHalf                int
+	wins, ties, scoops, splits int
+}
+
+// add counts one deal from every player's share of the pot, hero first
+func (s *shareStats) add(shares []float64) {
+	share, top := shares[0], 0.0
+	for _, o := range shares[1:] {
+		top = max(top, o)
+	}
+	s.n++
+	s.sum += share
+	s.sumSq += share * share
+	if share >= 0.5 {
+		s.atLeastHalf++
+	}
+	switch {
+	case share > top:
+		s.wins++
+	case share == top:
+		s.ties++
+	}
+	switch {
+	case top == 0:
+		s.scoops++
+	case share > 0:
+		s.splits++
+	}
+}
+
+func (s *shareStats) merge(o *shareStats) {
+	s.n += o.n
+	s.sum += o.sum
+	s.sumSq += o.sumSq
+	s.atLeastHalf += o.atLeastHalf
+	s.wins += o.wins
+	s.ties += o.ties
+	s.scoops += o.scoops
+	s.splits += o.splits
+}
+
+func (s *shareStats) result() EquityResult {
+	if s.n == 0 {
+		return EquityResult{}
+	}
+	n := float64(s.n)
+	mean := s.sum / n
+	variance := s.sumSq/n - mean*mean
+	if variance < 0 {
+		variance = 0 // rounding
+	}
+	return EquityResult{
+		Equity:      mean,
+		StdErr:      math.Sqrt(variance / n),
+		Variance:    variance,
+		AtLeastHalf: float64(s.atLeastHalf) / n,
+		Win:         float64(s.wins) / n,
+		Tie:         float64(s.ties) / n,
+		Loss:        float64(s.n-s.wins-s.ties) / n,
+		Scoop:       float64(s.scoops) / n,
+		Split:       float64(s.splits) / n,
+		Iterations:  s.n,
+	}
+}
+
+// Simulator runs Monte‑Carlo simulations on a pool of worker goroutines.
+// The zero value is ready to use.
+type Simulator struct {
+	// Workers is the number of goroutines deals are sharded across
+	// (default runtime.GOMAXPROCS(0))
+	Workers int
+	// Seed determines every deal. The same Seed gives the same results
+	// whatever the number of Workers.
+	Seed int64
+	// Ranges holds the opponents' range of each game by name; games
+	// without one are played against random hands
+	Ranges map[string]Range
+	// Dead holds cards known to be out of play, such as exposed or burned
+	// cards, which are never dealt. They must not be in the hero's hand.
+	Dead CardSet
+}
+
+// Equity simulates iters deals of g at a table of `players` (hero
+// included). The deals are split into shards, each dealt from its own
+// random source derived from s.Seed, and the shard results are merged in
+// shard order, never in completion order. If ctx is cancelled the deals
+// finished so far are returned along with ctx.Err(). Games that aren't
+// implemented aren't dealt at all: the result and error carry
+// ErrNotImplemented.
+func (s Simulator) Equity(ctx context.Context, g Game, my4 []Card, iters, players int) (EquityResult, error) {
+	if err := notImplemented(g); err != nil {
+		return EquityResult{Err: err}, err
+	}
+	var stats shareStats
+	err := s.simulateInto(ctx, &stats, 0, g, my4, iters, players)
+	return stats.result(), err
+}
+
+// PickBestGame is PickBestGameWithRisk on this simulator's worker pool. It
+// stops at the first game interrupted by ctx and returns the games finished
+// so far with ctx.Err().
+func (s Simulator) PickBestGame(ctx context.Context, my4 []Card, iters, players int, risk RiskPreference, games ...Game) (Selection, error) {
+	return pickBest(games, players, s.Dead, risk, func(g Game) (EquityResult, error) {
+		return s.Equity(ctx, g, my4, iters, players)
+	})
+}
+
+// workers returns the size of the worker pool
+func (s Simulator) workers() int {
+	if s.Workers > 0 {
+		return s.Workers
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
+// simShards is the number of independently seeded shards a simulation is
+// split into. It is fixed so that results don't depend on the worker count.
+const simShards = 64
+
+// simulateInto deals iters deals across the worker pool and adds them to
+// stats. Callers that simulate the same game more than once pass a
+// different stream each time so the batches don't repeat the same deals.
+func (s Simulator) simulateInto(ctx context.Context, stats *shareStats, stream int, g Game, my4 []Card, iters, players int) error {
+	if players < 2 {
+		panic("SimulateEquity: need at least 2 players")
+	}
+	if overlap := s.Dead.Intersect(ToSet(my4)); overlap != 0 {
+		return fmt.Errorf("dead cards %s overlap the hero's hand", overlap)
+	}
+	if err := checkDeck(g, players, s.Dead); err != nil {
+		return err
+	}
+	shards := min(simShards, max(iters, 1))
+	parts := make([]shareStats, shards)
+	errs := make([]error, shards)
+	next := make(chan int, shards)
+	for i := 0; i < shards; i++ {
+		next <- i
+	}
+	close(next)
+
+	var wg sync.WaitGroup
+	for w := min(s.workers(), shards); w > 0; w-- {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				n := iters / shards
+				if i < iters%shards {
+					n++
+				}
+				r := mrand.New(mrand.NewSource(shardSeed(s.Seed, stream, i)))
+				errs[i] = simulateWorker(ctx, r, &parts[i], g, my4, n, players, s.Ranges[g.Name()], s.Dead)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i := range parts {
+		stats.merge(&parts[i])
+	}
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// shardSeed derives the seed of one shard of one stream from seed, mixing
+// with the SplitMix64 finalizer so neighbouring shards get unrelated sources
+func shardSeed(seed int64, stream, shard int) int64 {
+	x := uint64(seed)
+	for _, v := range [2]int{stream, shard} {
+		x += uint64(v) + 0x9e3779b97f4a7c15
+		x = (x ^ x>>30) * 0xbf58476d1ce4e5b9
+		x = (x ^ x>>27) * 0x94d049bb133111eb
+		x ^= x >> 31
 	}
+	return int64(x)
+}
+
+// ctxCheckInterval is how many deals a worker plays between context checks
+const ctxCheckInterval = 1024
 
-	wins, ties := 0, 0
+// simulateWorker adds iters deals dealt from r to stats, with opponents
+// playing opp (nil for random hands) and the dead cards left out of the deck
+func simulateWorker(ctx context.Context, r *mrand.Rand, stats *shareStats, g Game, my4 []Card, iters, players int, opp Range, dead CardSet) error {
+	w := newDealer(r, g, my4, players, opp, dead)
 	for i := 0; i < iters; i++ {
-		deck := RemoveCards(FullDeck(), ToSet(my4))
-		myHand, oppHand, board, _ := g.CompleteHand(my4, deck)
-		myScore := g.Evaluate(myHand, board)
-		oppScore := g.Evaluate(oppHand, board)
-		if myScore > oppScore {
-			wins++
-		} else if myScore == oppScore {
-			ties++
+		if i%ctxCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
 		}
+		shares, err := w.deal()
+		if err != nil {
+			return fmt.Errorf("%s: %w", g.Name(), err)
+		}
+		stats.add(shares)
 	}
-	return (float64(wins) + float64(ties)/2) / float64(iters)
+	return nil
+}
+
+// dealer plays one worker's deals, reusing the same deck, Deal and
+// showdown buffers for every deal so the loop doesn't allocate
+type dealer struct {
+	r        *mrand.Rand
+	g        Game
+	my4      []Card
+	live     []Card // the deck without the hero's cards
+	d        *Deal
+	showdown showdown
+}
+
+func newDealer(r *mrand.Rand, g Game, my4 []Card, players int, opp Range, dead CardSet) *dealer {
+	live := AllCards.Minus(ToSet(my4)).Minus(dead).Cards()
+	d := NewDeal(live, players)
+	d.Range, d.Dead = opp, dead
+	return &dealer{r: r, g: g, my4: my4, live: live, d: d}
+}
+
+// deal plays one deal and returns every player's share of the pot, hero
+// first, valid until the next deal
+func (w *dealer) deal() ([]float64, error) {
+	w.d.Reset(w.live)
+	w.g.CompleteHand(w.r, w.my4, w.d)
+	if err := w.d.Err(); err != nil {
+		return nil, err
+	}
+	return w.showdown.settle(w.g, w.d.Hands, w.d.Board), nil
+}
+
+// SimulateEquity returns the hero's expected share of the pot at a table of
+// `players` (hero included) by Monte‑Carlo. Split pots and ties are settled
+// by Showdown, so a hand with no edge scores 1/players. The package-level
+// functions use the zero Simulator, so they always deal with seed 0.
+func SimulateEquity(g Game, my4 []Card, iters, players int) float64 {
+	return SimulateEquityResult(g, my4, iters, players).Equity
+}
+
+// SimulateEquityResult is SimulateEquity with the spread of the pot share
+func SimulateEquityResult(g Game, my4 []Card, iters, players int) EquityResult {
+	res, _ := Simulator{}.Equity(context.Background(), g, my4, iters, players)
+	return res
+}
+
+// PickBestGame finds the game variant with the highest equity for the given
+// hand at a table of `players`. It chooses among games, or every
+// implemented registered game when none are given. Games that aren't
+// implemented are never chosen; they are listed last with
+// ErrNotImplemented.
+func PickBestGame(my4 []Card, iters, players int, games ...Game) Selection {
+	return PickBestGameWithRisk(my4, iters, players, RiskNeutral, games...)
+}
+
+// PickBestGameWithRisk finds the best game variant for the given hand, ranking
+// games by risk.Score. The equities in the results are always the raw pot
+// shares.
+func PickBestGameWithRisk(my4 []Card, iters, players int, risk RiskPreference, games ...Game) Selection {
+	sel, _ := Simulator{}.PickBestGame(context.Background(), my4, iters, players, risk, games...)
+	return sel
 }
 
-// PickBestGame finds the best game variant for the given hand
-func PickBestGame(my4 []Card, iters int) (best Game, equities map[string]float64) {
-	games := []Game{
-		HiDuGiGame{}, // Put HiDuGi first so it wins ties with split pot preference
-		DrawmahaHi{},
-		BadugiGame{},
-		StubGame{"Drawmaha-2-7"},
-		StubGame{"Prime"},
-		StubGame{"Omaha DoubleBoard"},
+// PickBestGameExact is PickBestGameWithRisk heads-up with every game's
+// equity from ExactEquity, so results are reproducible wherever the deal
+// space can be enumerated.
+func PickBestGameExact(my4 []Card, risk RiskPreference, games ...Game) Selection {
+	sel, _ := Simulator{}.PickBestGameExact(context.Background(), my4, risk, games...)
+	return sel
+}
+
+// PickBestGameExact is the package-level PickBestGameExact with the games
+// that can't be enumerated simulated on this simulator, so they are dealt
+// from s.Seed. It stops like PickBestGame when ctx is cancelled.
+func (s Simulator) PickBestGameExact(ctx context.Context, my4 []Card, risk RiskPreference, games ...Game) (Selection, error) {
+	return pickBest(games, 2, 0, risk, func(g Game) (EquityResult, error) {
+		return s.ExactEquity(ctx, g, my4)
+	})
+}
+
+// ErrTableSize is returned for games whose player limits exclude the table
+var ErrTableSize = errors.New("not played by this many players")
+
+// ErrShortDeck is returned when the cards left once the dead cards are
+// removed can't deal a game
+var ErrShortDeck = errors.New("too few cards left after the dead cards")
+
+// candidateGames returns the games to choose from at a table of `players`:
+// games, or every implemented registered game when games is empty, less
+// games that aren't implemented and those unfit for the table
+func candidateGames(games []Game, players int, dead CardSet) []Game {
+	if len(games) == 0 {
+		games = selectableGames()
 	}
-	equities = make(map[string]float64, len(games))
+	var out []Game
 	for _, g := range games {
-		eq := SimulateEquity(g, my4, iters)
-		equities[g.Name()] = eq
-		if best == nil || eq > equities[best.Name()] {
-			best = g
+		if Implemented(g) && unfit(g, players, dead) == nil {
+			out = append(out, g)
 		}
 	}
-	return
+	return out
+}
+
+// unfit returns ErrTableSize if g is registered with player limits that
+// exclude the table, and otherwise the error of checkDeck
+func unfit(g Game, players int, dead CardSet) error {
+	if info, ok := LookupGame(g.Name()); ok && !info.Fits(players) {
+		return fmt.Errorf("%s: %w: %d players, it seats %d to %d", g.Name(), ErrTableSize, players, info.MinPlayers, info.MaxPlayers)
+	}
+	return checkDeck(g, players, dead)
+}
+
+// checkDeck returns ErrShortDeck if the cards left once dead cards are
+// removed can't deal g to `players` players: every hand and board and, in
+// draw games, a full 5-card draw from the deck and muck. Games that are
+// neither registered nor a FixedDeal aren't checked.
+func checkDeck(g Game, players int, dead CardSet) error {
+	var need int
+	if info, ok := LookupGame(g.Name()); ok {
+		need = info.HoleCards*players + 5*info.Boards
+		if info.Draws > 0 {
+			need += 5
+		}
+	} else if fd, ok := g.(FixedDeal); ok {
+		hole, board := fd.DealSizes()
+		need = hole*players + board
+	}
+	if live := 52 - dead.Count(); need > live {
+		return fmt.Errorf("%s: %w: it needs %d cards for %d players, %d are left", g.Name(), ErrShortDeck, need, players, live)
+	}
+	return nil
 }
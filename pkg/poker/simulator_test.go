@@ -1,6 +1,9 @@
 package poker
 
 import (
+	"context"
+	"errors"
+	"math"
 	"testing"
 )
 
@@ -19,9 +22,9 @@ func TestSimulateEquity(t *testing.T) {
 			hand: []Card{
 				mustCard("As"), mustCard("Ad"), mustCard("Ah"), mustCard("Ac"),
 			},
-			minEquity:   0.99,
-			maxEquity:   1.0,
-			description: "Should have near 100% equity",
+			minEquity:   0.6,
+			maxEquity:   0.85,
+			description: "Should lock up the draw half but need help in the Omaha half",
 		},
 		{
 			name: "Low straight draw in Drawmaha-Hi",
@@ -29,9 +32,19 @@ func TestSimulateEquity(t *testing.T) {
 			hand: []Card{
 				mustCard("2s"), mustCard("3d"), mustCard("4h"), mustCard("5c"),
 			},
-			minEquity:   0.2,
-			maxEquity:   0.4,
-			description: "Should have low equity",
+			minEquity:   0.3,
+			maxEquity:   0.55,
+			description: "Should draw at a straight without dominating",
+		},
+		{
+			name: "Four aces in HiDuGi",
+			game: HiDuGiGame{},
+			hand: []Card{
+				mustCard("As"), mustCard("Ad"), mustCard("Ah"), mustCard("Ac"),
+			},
+			minEquity:   0.5,
+			maxEquity:   1.0,
+			description: "Should lock up the high half without exceeding a whole pot",
 		},
 		{
 			name: "Perfect badugi hand",
@@ -47,7 +60,7 @@ func TestSimulateEquity(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			equity := SimulateEquity(tt.game, tt.hand, 1000)
+			equity := SimulateEquity(tt.game, tt.hand, 1000, 2)
 			if equity < tt.minEquity || equity > tt.maxEquity {
 				t.Errorf("%s: equity = %.3f, want between %.3f and %.3f",
 					tt.description, equity, tt.minEquity, tt.maxEquity)
@@ -60,34 +73,278 @@ func TestPickBestGame(t *testing.T) {
 	tests := []struct {
 		name     string
 		hand     []Card
+		players  int
 		wantGame string
 	}{
 		{
-			name: "Four aces should pick HiDuGi",
+			name: "Four aces should pick Drawmaha-Hi",
 			hand: []Card{
 				mustCard("As"), mustCard("Ad"), mustCard("Ah"), mustCard("Ac"),
 			},
-			wantGame: "HiDuGi",
+			players:  2,
+			wantGame: "Drawmaha-Hi",
 		},
 		{
 			name: "Low rainbow cards should pick Badugi",
 			hand: []Card{
 				mustCard("2s"), mustCard("3d"), mustCard("4h"), mustCard("5c"),
 			},
+			players:  2,
 			wantGame: "Badugi",
 		},
+		{
+			name: "Four aces six-handed should pick Drawmaha-Hi",
+			hand: []Card{
+				mustCard("As"), mustCard("Ad"), mustCard("Ah"), mustCard("Ac"),
+			},
+			players:  6,
+			wantGame: "Drawmaha-Hi",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			best, equities := PickBestGame(tt.hand, 1000)
-			if best.Name() != tt.wantGame {
-				t.Errorf("PickBestGame() selected %s, want %s", best.Name(), tt.wantGame)
+			sel := PickBestGame(tt.hand, 1000, tt.players)
+			if sel.Best.Name() != tt.wantGame {
+				t.Errorf("PickBestGame() selected %s, want %s", sel.Best.Name(), tt.wantGame)
 				// Print all equities for debugging
-				for game, eq := range equities {
-					t.Logf("%s: %.3f", game, eq)
+				for _, r := range sel.Results {
+					t.Logf("%s: %.3f", r.Name(), r.Equity)
 				}
 			}
 		})
 	}
 }
+
+func TestSimulateEquityResult(t *testing.T) {
+	// Four aces win at least the high half of HiDuGi unless a 4-card straight flush shows up
+	hand := []Card{mustCard("As"), mustCard("Ad"), mustCard("Ah"), mustCard("Ac")}
+	res := SimulateEquityResult(HiDuGiGame{}, hand, 1000, 2)
+	if res.Iterations != 1000 {
+		t.Errorf("Iterations = %d, want 1000", res.Iterations)
+	}
+	if res.AtLeastHalf < 0.99 {
+		t.Errorf("AtLeastHalf = %.3f, want at least 0.99", res.AtLeastHalf)
+	}
+	// Shares are 0.5 or 1, so the variance can't exceed 0.25^2
+	if res.Variance < 0 || res.Variance > 0.0625 {
+		t.Errorf("Variance = %.4f, want between 0 and 0.0625", res.Variance)
+	}
+}
+
+func TestRiskPreferenceScore(t *testing.T) {
+	// A coin flip for the whole pot vs. a locked half with a small scoop chance
+	gamble := EquityResult{Equity: 0.6, Variance: 0.24, AtLeastHalf: 0.6}
+	safe := EquityResult{Equity: 0.55, Variance: 0.0225, AtLeastHalf: 1.0}
+
+	tests := []struct {
+		name     string
+		risk     RiskPreference
+		wantSafe bool
+	}{
+		{"Risk neutral takes the higher equity", RiskNeutral, false},
+		{"Risk averse takes the locked half", RiskAverse, true},
+		{"Variance weight alone", RiskPreference{VarianceWeight: 0.5}, true},
+		{"Half pot weight alone", RiskPreference{HalfPotWeight: 0.2}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSafe := tt.risk.Score(safe) > tt.risk.Score(gamble)
+			if gotSafe != tt.wantSafe {
+				t.Errorf("prefers safe = %v, want %v", gotSafe, tt.wantSafe)
+			}
+		})
+	}
+}
+
+func TestSimulateEquityMultiway(t *testing.T) {
+	tests := []struct {
+		name      string
+		game      Game
+		hand      []Card
+		players   int
+		minEquity float64
+		maxEquity float64
+	}{
+		{
+			name: "Four aces still take the draw half six-handed",
+			game: DrawmahaHi{},
+			hand: []Card{
+				mustCard("As"), mustCard("Ad"), mustCard("Ah"), mustCard("Ac"),
+			},
+			players:   6,
+			minEquity: 0.45,
+			maxEquity: 0.7,
+		},
+		{
+			name: "Perfect badugi still wins eight-handed",
+			game: BadugiGame{},
+			hand: []Card{
+				mustCard("As"), mustCard("2d"), mustCard("3h"), mustCard("4c"),
+			},
+			players:   8,
+			minEquity: 0.9,
+			maxEquity: 1.0,
+		},
+		{
+			name: "Middling Omaha hand loses value six-handed",
+			game: OmahaDoubleBoard{},
+			hand: []Card{
+				mustCard("9s"), mustCard("8d"), mustCard("4h"), mustCard("2c"),
+			},
+			players:   6,
+			minEquity: 0.05,
+			maxEquity: 0.2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			equity := SimulateEquity(tt.game, tt.hand, 1000, tt.players)
+			if equity < tt.minEquity || equity > tt.maxEquity {
+				t.Errorf("equity = %.3f, want between %.3f and %.3f", equity, tt.minEquity, tt.maxEquity)
+			}
+		})
+	}
+}
+
+func TestSimulatorEquity(t *testing.T) {
+	hand := []Card{mustCard("Ks"), mustCard("Qd"), mustCard("5h"), mustCard("2c")}
+	exact := ExactEquity(HiDuGiGame{}, hand).Equity
+
+	for _, workers := range []int{1, 3, 8, 64} {
+		res, err := Simulator{Workers: workers}.Equity(context.Background(), HiDuGiGame{}, hand, 10000, 2)
+		if err != nil {
+			t.Fatalf("workers=%d: unexpected error %v", workers, err)
+		}
+		if res.Iterations != 10000 {
+			t.Errorf("workers=%d: Iterations = %d, want 10000", workers, res.Iterations)
+		}
+		if math.Abs(res.Equity-exact) > 0.03 {
+			t.Errorf("workers=%d: equity %.4f too far from exact %.4f", workers, res.Equity, exact)
+		}
+	}
+
+	// More workers than deals
+	res, _ := Simulator{Workers: 16}.Equity(context.Background(), BadugiGame{}, hand, 3, 2)
+	if res.Iterations != 3 {
+		t.Errorf("Iterations = %d, want 3", res.Iterations)
+	}
+}
+
+func TestSimulatorCancel(t *testing.T) {
+	hand := []Card{mustCard("Ks"), mustCard("Qd"), mustCard("5h"), mustCard("2c")}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res, err := Simulator{Workers: 4}.Equity(ctx, DrawmahaHi{}, hand, 1000000, 2)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want context.Canceled", err)
+	}
+	if res.Iterations != 0 {
+		t.Errorf("Iterations = %d, want no deals after cancellation", res.Iterations)
+	}
+
+	_, err = Simulator{}.PickBestGame(ctx, hand, 1000000, 2, RiskNeutral)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("PickBestGame err = %v, want context.Canceled", err)
+	}
+}
+
+func TestSimulatorSeed(t *testing.T) {
+	hand := []Card{mustCard("As"), mustCard("Kd"), mustCard("7h"), mustCard("2c")}
+	equity := func(s Simulator) EquityResult {
+		t.Helper()
+		res, err := s.Equity(context.Background(), DrawmahaHi{}, hand, 2000, 4)
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		return res
+	}
+
+	want := equity(Simulator{Workers: 1, Seed: 42})
+	for _, workers := range []int{1, 3, 8} {
+		if got := equity(Simulator{Workers: workers, Seed: 42}); got != want {
+			t.Errorf("workers=%d: %+v, want %+v from the same seed", workers, got, want)
+		}
+	}
+	if got := equity(Simulator{Workers: 1, Seed: 43}); got == want {
+		t.Errorf("seeds 42 and 43 gave identical results %+v", got)
+	}
+}
+
+func TestSimulatorDead(t *testing.T) {
+	hand := mustHand("Ks 2d 3h 4c")
+	ctx := context.Background()
+
+	if _, err := (Simulator{Dead: ToSet(mustHand("9s 4c"))}).Equity(ctx, BadugiGame{}, hand, 100, 2); err == nil {
+		t.Error("dead card in the hero's hand accepted")
+	}
+
+	// Killing the aces and fives leaves opponents fewer wheel cards to beat a king-high badugi with
+	dead := ToSet(mustHand("As Ad Ah Ac 5s 5d 5h 5c"))
+	live, err := Simulator{Dead: dead}.Equity(ctx, BadugiGame{}, hand, 5000, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	full := SimulateEquityResult(BadugiGame{}, hand, 5000, 4)
+	if live.Equity <= full.Equity {
+		t.Errorf("equity with dead low cards = %.3f, want above %.3f with a full deck", live.Equity, full.Equity)
+	}
+
+	// Dead cards the table can't be dealt without are an error, not a panic
+	tests := []struct {
+		game    Game
+		players int
+		dead    string
+	}{
+		{OmahaDoubleBoard{}, 10, "2s 2d 2h 2c 3s 3d 3h 3c 4s 4d"},
+		{Drawmaha27{}, 8, "2s 2d 2h"}, // no room for a 5-card draw
+	}
+	for _, tt := range tests {
+		s := Simulator{Dead: ToSet(mustHand(tt.dead))}
+		if _, err := s.Equity(ctx, tt.game, mustHand("As Ks Qs Js"), 100, tt.players); err == nil {
+			t.Errorf("%s with %d players and %d dead cards accepted", tt.game.Name(), tt.players, len(mustHand(tt.dead)))
+		}
+		if games := candidateGames([]Game{tt.game}, tt.players, s.Dead); len(games) != 0 {
+			t.Errorf("candidateGames kept %s with %d players and %d dead cards", tt.game.Name(), tt.players, len(mustHand(tt.dead)))
+		}
+		sel, _ := s.PickBestGame(ctx, mustHand("As Ks Qs Js"), 100, tt.players, RiskNeutral, tt.game)
+		if r, _ := sel.Result(tt.game.Name()); !errors.Is(r.Err, ErrShortDeck) {
+			t.Errorf("%s with %d dead cards: Err = %v, want ErrShortDeck", tt.game.Name(), len(mustHand(tt.dead)), r.Err)
+		}
+	}
+	if _, err := (Simulator{Dead: ToSet(mustHand("2s 2d 2h"))}).Equity(ctx, Drawmaha27{}, mustHand("As Ks Qs Js"), 100, 7); err != nil {
+		t.Errorf("Drawmaha-2-7 7-handed with 3 dead cards: %v", err)
+	}
+
+	for _, g := range []Game{DrawmahaHi{}, OmahaDoubleBoard{}} {
+		w := newDealer(testRand(), g, hand, 6, nil, dead)
+		for i := 0; i < 200; i++ {
+			w.deal()
+			for _, h := range append(w.d.Hands, w.d.Board) {
+				if overlap := ToSet(h).Intersect(dead); overlap != 0 {
+					t.Fatalf("%s: dead cards %s dealt", g.Name(), overlap)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkSimulateEquity(b *testing.B) {
+	hand := []Card{mustCard("As"), mustCard("Kd"), mustCard("7h"), mustCard("2c")}
+	for _, g := range selectableGames() {
+		b.Run(g.Name(), func(b *testing.B) {
+			w := newDealer(testRand(), g, hand, 6, nil, 0)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				w.deal()
+			}
+			b.StopTimer()
+			if allocs := testing.AllocsPerRun(100, func() { w.deal() }); allocs != 0 {
+				b.Errorf("%v allocs/op, want 0", allocs)
+			}
+		})
+	}
+}
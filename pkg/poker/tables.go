@@ -0,0 +1,2710 @@
+// Code generated by gen_tables.go; DO NOT EDIT.
+
+package poker
+
+// high5Table holds Lookup5CardHigh classes of 5-card hands, by rankIndex
+var high5Table = [6188]uint16{
+	0, 7297, 7141, 7153, 7309, 0, 7298, 4996, 4138, 5062, 7310, 7142, 4149, 4160, 7154, 7165,
+	5128, 7166, 7321, 7322, 0, 7299, 4997, 4139, 5063, 7311, 4998, 1278, 1498, 5064, 4150, 1718,
+	4161, 5129, 5130, 7323, 7143, 4171, 4182, 7155, 4172, 1938, 4183, 4193, 4194, 7167, 7177, 5194,
+	7178, 5195, 5196, 7179, 7333, 7334, 7335, 0, 7300, 4999, 4140, 5065, 7312, 5000, 1279, 1499,
+	5066, 4151, 1719, 4162, 5131, 5132, 7324, 5001, 1280, 1500, 5067, 1281, 5855, 1501, 1720, 1721,
+	5133, 4173, 1939, 4184, 1940, 1941, 4195, 5197, 5198, 5199, 7336, 7144, 4204, 4215, 7156, 4205,
+	2158, 4216, 4226, 4227, 7168, 4206, 2159, 4217, 2160, 2161, 4228, 4237, 4238, 4239, 7180, 7189,
+	5260, 7190, 5261, 5262, 7191, 5263, 5264, 5265, 7192, 7345, 7346, 7347, 7348, 0, 7301, 5002,
+	4141, 5068, 7313, 5003, 1282, 1502, 5069, 4152, 1722, 4163, 5134, 5135, 7325, 5004, 1283, 1503,
+	5070, 1284, 1, 1504, 1723, 1724, 5136, 4174, 1942, 4185, 1943, 1944, 4196, 5200, 5201, 5202,
+	7337, 5005, 1285, 1505, 5071, 1286, 2, 1506, 1725, 1726, 5137, 1287, 3, 1507, 4, 5856,
+	1727, 1945, 1946, 1947, 5203, 4207, 2162, 4218, 2163, 2164, 4229, 2165, 2166, 2167, 4240, 5266,
+	5267, 5268, 5269, 7349, 7145, 4248, 4259, 7157, 4249, 2378, 4260, 4270, 4271, 7169, 4250, 2379,
+	4261, 2380, 2381, 4272, 4281, 4282, 4283, 7181, 4251, 2382, 4262, 2383, 2384, 4273, 2385, 2386,
+	2387, 4284, 4292, 4293, 4294, 4295, 7193, 7201, 5326, 7202, 5327, 5328, 7203, 5329, 5330, 5331,
+	7204, 5332, 5333, 5334, 5335, 7205, 7357, 7358, 7359, 7360, 7361, 0, 7302, 5006, 4142, 5072,
+	7314, 5007, 1288, 1508, 5073, 4153, 1728, 4164, 5138, 5139, 7326, 5008, 1289, 1509, 5074, 1290,
+	5, 1510, 1729, 1730, 5140, 4175, 1948, 4186, 1949, 1950, 4197, 5204, 5205, 5206, 7338, 5009,
+	1291, 1511, 5075, 1292, 6, 1512, 1731, 1732, 5141, 1293, 7, 1513, 8, 9, 1733, 1951,
+	1952, 1953, 5207, 4208, 2168, 4219, 2169, 2170, 4230, 2171, 2172, 2173, 4241, 5270, 5271, 5272,
+	5273, 7350, 5010, 1294, 1514, 5076, 1295, 10, 1515, 1734, 1735, 5142, 1296, 11, 1516, 12,
+	13, 1736, 1954, 1955, 1956, 5208, 1297, 14, 1517, 15, 16, 1737, 17, 18, 5857, 1957,
+	2174, 2175, 2176, 2177, 5274, 4252, 2388, 4263, 2389, 2390, 4274, 2391, 2392, 2393, 4285, 2394,
+	2395, 2396, 2397, 4296, 5336, 5337, 5338, 5339, 5340, 7362, 7146, 4303, 4314, 7158, 4304, 2598,
+	4315, 4325, 4326, 7170, 4305, 2599, 4316, 2600, 2601, 4327, 4336, 4337, 4338, 7182, 4306, 2602,
+	4317, 2603, 2604, 4328, 2605, 2606, 2607, 4339, 4347, 4348, 4349, 4350, 7194, 4307, 2608, 4318,
+	2609, 2610, 4329, 2611, 2612, 2613, 4340, 2614, 2615, 2616, 2617, 4351, 4358, 4359, 4360, 4361,
+	4362, 7206, 7213, 5392, 7214, 5393, 5394, 7215, 5395, 5396, 5397, 7216, 5398, 5399, 5400, 5401,
+	7217, 5402, 5403, 5404, 5405, 5406, 7218, 7369, 7370, 7371, 7372, 7373, 7374, 0, 7303, 5011,
+	4143, 5077, 7315, 5012, 1298, 1518, 5078, 4154, 1738, 4165, 5143, 5144, 7327, 5013, 1299, 1519,
+	5079, 1300, 19, 1520, 1739, 1740, 5145, 4176, 1958, 4187, 1959, 1960, 4198, 5209, 5210, 5211,
+	7339, 5014, 1301, 1521, 5080, 1302, 20, 1522, 1741, 1742, 5146, 1303, 21, 1523, 22, 23,
+	1743, 1961, 1962, 1963, 5212, 4209, 2178, 4220, 2179, 2180, 4231, 2181, 2182, 2183, 4242, 5275,
+	5276, 5277, 5278, 7351, 5015, 1304, 1524, 5081, 1305, 24, 1525, 1744, 1745, 5147, 1306, 25,
+	1526, 26, 27, 1746, 1964, 1965, 1966, 5213, 1307, 28, 1527, 29, 30, 1747, 31, 32,
+	33, 1967, 2184, 2185, 2186, 2187, 5279, 4253, 2398, 4264, 2399, 2400, 4275, 2401, 2402, 2403,
+	4286, 2404, 2405, 2406, 2407, 4297, 5341, 5342, 5343, 5344, 5345, 7363, 5016, 1308, 1528, 5082,
+	1309, 34, 1529, 1748, 1749, 5148, 1310, 35, 1530, 36, 37, 1750, 1968, 1969, 1970, 5214,
+	1311, 38, 1531, 39, 40, 1751, 41, 42, 43, 1971, 2188, 2189, 2190, 2191, 5280, 1312,
+	44, 1532, 45, 46, 1752, 47, 48, 49, 1972, 50, 51, 52, 5858, 2192, 2408, 2409,
+	2410, 2411, 2412, 5346, 4308, 2618, 4319, 2619, 2620, 4330, 2621, 2622, 2623, 4341, 2624, 2625,
+	2626, 2627, 4352, 2628, 2629, 2630, 2631, 2632, 4363, 5407, 5408, 5409, 5410, 5411, 5412, 7375,
+	7147, 4369, 4380, 7159, 4370, 2818, 4381, 4391, 4392, 7171, 4371, 2819, 4382, 2820, 2821, 4393,
+	4402, 4403, 4404, 7183, 4372, 2822, 4383, 2823, 2824, 4394, 2825, 2826, 2827, 4405, 4413, 4414,
+	4415, 4416, 7195, 4373, 2828, 4384, 2829, 2830, 4395, 2831, 2832, 2833, 4406, 2834, 2835, 2836,
+	2837, 4417, 4424, 4425, 4426, 4427, 4428, 7207, 4374, 2838, 4385, 2839, 2840, 4396, 2841, 2842,
+	2843, 4407, 2844, 2845, 2846, 2847, 4418, 2848, 2849, 2850, 2851, 2852, 4429, 4435, 4436, 4437,
+	4438, 4439, 4440, 7219, 7225, 5458, 7226, 5459, 5460, 7227, 5461, 5462, 5463, 7228, 5464, 5465,
+	5466, 5467, 7229, 5468, 5469, 5470, 5471, 5472, 7230, 5473, 5474, 5475, 5476, 5477, 5478, 7231,
+	7381, 7382, 7383, 7384, 7385, 7386, 7387, 0, 7304, 5017, 4144, 5083, 7316, 5018, 1313, 1533,
+	5084, 4155, 1753, 4166, 5149, 5150, 7328, 5019, 1314, 1534, 5085, 1315, 53, 1535, 1754, 1755,
+	5151, 4177, 1973, 4188, 1974, 1975, 4199, 5215, 5216, 5217, 7340, 5020, 1316, 1536, 5086, 1317,
+	54, 1537, 1756, 1757, 5152, 1318, 55, 1538, 56, 57, 1758, 1976, 1977, 1978, 5218, 4210,
+	2193, 4221, 2194, 2195, 4232, 2196, 2197, 2198, 4243, 5281, 5282, 5283, 5284, 7352, 5021, 1319,
+	1539, 5087, 1320, 58, 1540, 1759, 1760, 5153, 1321, 59, 1541, 60, 61, 1761, 1979, 1980,
+	1981, 5219, 1322, 62, 1542, 63, 64, 1762, 65, 66, 67, 1982, 2199, 2200, 2201, 2202,
+	5285, 4254, 2413, 4265, 2414, 2415, 4276, 2416, 2417, 2418, 4287, 2419, 2420, 2421, 2422, 4298,
+	5347, 5348, 5349, 5350, 5351, 7364, 5022, 1323, 1543, 5088, 1324, 68, 1544, 1763, 1764, 5154,
+	1325, 69, 1545, 70, 71, 1765, 1983, 1984, 1985, 5220, 1326, 72, 1546, 73, 74, 1766,
+	75, 76, 77, 1986, 2203, 2204, 2205, 2206, 5286, 1327, 78, 1547, 79, 80, 1767, 81,
+	82, 83, 1987, 84, 85, 86, 87, 2207, 2423, 2424, 2425, 2426, 2427, 5352, 4309, 2633,
+	4320, 2634, 2635, 4331, 2636, 2637, 2638, 4342, 2639, 2640, 2641, 2642, 4353, 2643, 2644, 2645,
+	2646, 2647, 4364, 5413, 5414, 5415, 5416, 5417, 5418, 7376, 5023, 1328, 1548, 5089, 1329, 88,
+	1549, 1768, 1769, 5155, 1330, 89, 1550, 90, 91, 1770, 1988, 1989, 1990, 5221, 1331, 92,
+	1551, 93, 94, 1771, 95, 96, 97, 1991, 2208, 2209, 2210, 2211, 5287, 1332, 98, 1552,
+	99, 100, 1772, 101, 102, 103, 1992, 104, 105, 106, 107, 2212, 2428, 2429, 2430, 2431,
+	2432, 5353, 1333, 108, 1553, 109, 110, 1773, 111, 112, 113, 1993, 114, 115, 116, 117,
+	2213, 118, 119, 120, 121, 5859, 2433, 2648, 2649, 2650, 2651, 2652, 2653, 5419, 4375, 2853,
+	4386, 2854, 2855, 4397, 2856, 2857, 2858, 4408, 2859, 2860, 2861, 2862, 4419, 2863, 2864, 2865,
+	2866, 2867, 4430, 2868, 2869, 2870, 2871, 2872, 2873, 4441, 5479, 5480, 5481, 5482, 5483, 5484,
+	5485, 7388, 7148, 4446, 4457, 7160, 4447, 3038, 4458, 4468, 4469, 7172, 4448, 3039, 4459, 3040,
+	3041, 4470, 4479, 4480, 4481, 7184, 4449, 3042, 4460, 3043, 3044, 4471, 3045, 3046, 3047, 4482,
+	4490, 4491, 4492, 4493, 7196, 4450, 3048, 4461, 3049, 3050, 4472, 3051, 3052, 3053, 4483, 3054,
+	3055, 3056, 3057, 4494, 4501, 4502, 4503, 4504, 4505, 7208, 4451, 3058, 4462, 3059, 3060, 4473,
+	3061, 3062, 3063, 4484, 3064, 3065, 3066, 3067, 4495, 3068, 3069, 3070, 3071, 3072, 4506, 4512,
+	4513, 4514, 4515, 4516, 4517, 7220, 4452, 3073, 4463, 3074, 3075, 4474, 3076, 3077, 3078, 4485,
+	3079, 3080, 3081, 3082, 4496, 3083, 3084, 3085, 3086, 3087, 4507, 3088, 3089, 3090, 3091, 3092,
+	3093, 4518, 4523, 4524, 4525, 4526, 4527, 4528, 4529, 7232, 7237, 5524, 7238, 5525, 5526, 7239,
+	5527, 5528, 5529, 7240, 5530, 5531, 5532, 5533, 7241, 5534, 5535, 5536, 5537, 5538, 7242, 5539,
+	5540, 5541, 5542, 5543, 5544, 7243, 5545, 5546, 5547, 5548, 5549, 5550, 5551, 7244, 7393, 7394,
+	7395, 7396, 7397, 7398, 7399, 7400, 0, 7305, 5024, 4145, 5090, 7317, 5025, 1334, 1554, 5091,
+	4156, 1774, 4167, 5156, 5157, 7329, 5026, 1335, 1555, 5092, 1336, 122, 1556, 1775, 1776, 5158,
+	4178, 1994, 4189, 1995, 1996, 4200, 5222, 5223, 5224, 7341, 5027, 1337, 1557, 5093, 1338, 123,
+	1558, 1777, 1778, 5159, 1339, 124, 1559, 125, 126, 1779, 1997, 1998, 1999, 5225, 4211, 2214,
+	4222, 2215, 2216, 4233, 2217, 2218, 2219, 4244, 5288, 5289, 5290, 5291, 7353, 5028, 1340, 1560,
+	5094, 1341, 127, 1561, 1780, 1781, 5160, 1342, 128, 1562, 129, 130, 1782, 2000, 2001, 2002,
+	5226, 1343, 131, 1563, 132, 133, 1783, 134, 135, 136, 2003, 2220, 2221, 2222, 2223, 5292,
+	4255, 2434, 4266, 2435, 2436, 4277, 2437, 2438, 2439, 4288, 2440, 2441, 2442, 2443, 4299, 5354,
+	5355, 5356, 5357, 5358, 7365, 5029, 1344, 1564, 5095, 1345, 137, 1565, 1784, 1785, 5161, 1346,
+	138, 1566, 139, 140, 1786, 2004, 2005, 2006, 5227, 1347, 141, 1567, 142, 143, 1787, 144,
+	145, 146, 2007, 2224, 2225, 2226, 2227, 5293, 1348, 147, 1568, 148, 149, 1788, 150, 151,
+	152, 2008, 153, 154, 155, 156, 2228, 2444, 2445, 2446, 2447, 2448, 5359, 4310, 2654, 4321,
+	2655, 2656, 4332, 2657, 2658, 2659, 4343, 2660, 2661, 2662, 2663, 4354, 2664, 2665, 2666, 2667,
+	2668, 4365, 5420, 5421, 5422, 5423, 5424, 5425, 7377, 5030, 1349, 1569, 5096, 1350, 157, 1570,
+	1789, 1790, 5162, 1351, 158, 1571, 159, 160, 1791, 2009, 2010, 2011, 5228, 1352, 161, 1572,
+	162, 163, 1792, 164, 165, 166, 2012, 2229, 2230, 2231, 2232, 5294, 1353, 167, 1573, 168,
+	169, 1793, 170, 171, 172, 2013, 173, 174, 175, 176, 2233, 2449, 2450, 2451, 2452, 2453,
+	5360, 1354, 177, 1574, 178, 179, 1794, 180, 181, 182, 2014, 183, 184, 185, 186, 2234,
+	187, 188, 189, 190, 191, 2454, 2669, 2670, 2671, 2672, 2673, 2674, 5426, 4376, 2874, 4387,
+	2875, 2876, 4398, 2877, 2878, 2879, 4409, 2880, 2881, 2882, 2883, 4420, 2884, 2885, 2886, 2887,
+	2888, 4431, 2889, 2890, 2891, 2892, 2893, 2894, 4442, 5486, 5487, 5488, 5489, 5490, 5491, 5492,
+	7389, 5031, 1355, 1575, 5097, 1356, 192, 1576, 1795, 1796, 5163, 1357, 193, 1577, 194, 195,
+	1797, 2015, 2016, 2017, 5229, 1358, 196, 1578, 197, 198, 1798, 199, 200, 201, 2018, 2235,
+	2236, 2237, 2238, 5295, 1359, 202, 1579, 203, 204, 1799, 205, 206, 207, 2019, 208, 209,
+	210, 211, 2239, 2455, 2456, 2457, 2458, 2459, 5361, 1360, 212, 1580, 213, 214, 1800, 215,
+	216, 217, 2020, 218, 219, 220, 221, 2240, 222, 223, 224, 225, 226, 2460, 2675, 2676,
+	2677, 2678, 2679, 2680, 5427, 1361, 227, 1581, 228, 229, 1801, 230, 231, 232, 2021, 233,
+	234, 235, 236, 2241, 237, 238, 239, 240, 241, 2461, 242, 243, 244, 245, 246, 5860,
+	2681, 2895, 2896, 2897, 2898, 2899, 2900, 2901, 5493, 4453, 3094, 4464, 3095, 3096, 4475, 3097,
+	3098, 3099, 4486, 3100, 3101, 3102, 3103, 4497, 3104, 3105, 3106, 3107, 3108, 4508, 3109, 3110,
+	3111, 3112, 3113, 3114, 4519, 3115, 3116, 3117, 3118, 3119, 3120, 3121, 4530, 5552, 5553, 5554,
+	5555, 5556, 5557, 5558, 5559, 7401, 7149, 4534, 4545, 7161, 4535, 3258, 4546, 4556, 4557, 7173,
+	4536, 3259, 4547, 3260, 3261, 4558, 4567, 4568, 4569, 7185, 4537, 3262, 4548, 3263, 3264, 4559,
+	3265, 3266, 3267, 4570, 4578, 4579, 4580, 4581, 7197, 4538, 3268, 4549, 3269, 3270, 4560, 3271,
+	3272, 3273, 4571, 3274, 3275, 3276, 3277, 4582, 4589, 4590, 4591, 4592, 4593, 7209, 4539, 3278,
+	4550, 3279, 3280, 4561, 3281, 3282, 3283, 4572, 3284, 3285, 3286, 3287, 4583, 3288, 3289, 3290,
+	3291, 3292, 4594, 4600, 4601, 4602, 4603, 4604, 4605, 7221, 4540, 3293, 4551, 3294, 3295, 4562,
+	3296, 3297, 3298, 4573, 3299, 3300, 3301, 3302, 4584, 3303, 3304, 3305, 3306, 3307, 4595, 3308,
+	3309, 3310, 3311, 3312, 3313, 4606, 4611, 4612, 4613, 4614, 4615, 4616, 4617, 7233, 4541, 3314,
+	4552, 3315, 3316, 4563, 3317, 3318, 3319, 4574, 3320, 3321, 3322, 3323, 4585, 3324, 3325, 3326,
+	3327, 3328, 4596, 3329, 3330, 3331, 3332, 3333, 3334, 4607, 3335, 3336, 3337, 3338, 3339, 3340,
+	3341, 4618, 4622, 4623, 4624, 4625, 4626, 4627, 4628, 4629, 7245, 7249, 5590, 7250, 5591, 5592,
+	7251, 5593, 5594, 5595, 7252, 5596, 5597, 5598, 5599, 7253, 5600, 5601, 5602, 5603, 5604, 7254,
+	5605, 5606, 5607, 5608, 5609, 5610, 7255, 5611, 5612, 5613, 5614, 5615, 5616, 5617, 7256, 5618,
+	5619, 5620, 5621, 5622, 5623, 5624, 5625, 7257, 7405, 7406, 7407, 7408, 7409, 7410, 7411, 7412,
+	7413, 0, 7306, 5032, 4146, 5098, 7318, 5033, 1362, 1582, 5099, 4157, 1802, 4168, 5164, 5165,
+	7330, 5034, 1363, 1583, 5100, 1364, 247, 1584, 1803, 1804, 5166, 4179, 2022, 4190, 2023, 2024,
+	4201, 5230, 5231, 5232, 7342, 5035, 1365, 1585, 5101, 1366, 248, 1586, 1805, 1806, 5167, 1367,
+	249, 1587, 250, 251, 1807, 2025, 2026, 2027, 5233, 4212, 2242, 4223, 2243, 2244, 4234, 2245,
+	2246, 2247, 4245, 5296, 5297, 5298, 5299, 7354, 5036, 1368, 1588, 5102, 1369, 252, 1589, 1808,
+	1809, 5168, 1370, 253, 1590, 254, 255, 1810, 2028, 2029, 2030, 5234, 1371, 256, 1591, 257,
+	258, 1811, 259, 260, 261, 2031, 2248, 2249, 2250, 2251, 5300, 4256, 2462, 4267, 2463, 2464,
+	4278, 2465, 2466, 2467, 4289, 2468, 2469, 2470, 2471, 4300, 5362, 5363, 5364, 5365, 5366, 7366,
+	5037, 1372, 1592, 5103, 1373, 262, 1593, 1812, 1813, 5169, 1374, 263, 1594, 264, 265, 1814,
+	2032, 2033, 2034, 5235, 1375, 266, 1595, 267, 268, 1815, 269, 270, 271, 2035, 2252, 2253,
+	2254, 2255, 5301, 1376, 272, 1596, 273, 274, 1816, 275, 276, 277, 2036, 278, 279, 280,
+	281, 2256, 2472, 2473, 2474, 2475, 2476, 5367, 4311, 2682, 4322, 2683, 2684, 4333, 2685, 2686,
+	2687, 4344, 2688, 2689, 2690, 2691, 4355, 2692, 2693, 2694, 2695, 2696, 4366, 5428, 5429, 5430,
+	5431, 5432, 5433, 7378, 5038, 1377, 1597, 5104, 1378, 282, 1598, 1817, 1818, 5170, 1379, 283,
+	1599, 284, 285, 1819, 2037, 2038, 2039, 5236, 1380, 286, 1600, 287, 288, 1820, 289, 290,
+	291, 2040, 2257, 2258, 2259, 2260, 5302, 1381, 292, 1601, 293, 294, 1821, 295, 296, 297,
+	2041, 298, 299, 300, 301, 2261, 2477, 2478, 2479, 2480, 2481, 5368, 1382, 302, 1602, 303,
+	304, 1822, 305, 306, 307, 2042, 308, 309, 310, 311, 2262, 312, 313, 314, 315, 316,
+	2482, 2697, 2698, 2699, 2700, 2701, 2702, 5434, 4377, 2902, 4388, 2903, 2904, 4399, 2905, 2906,
+	2907, 4410, 2908, 2909, 2910, 2911, 4421, 2912, 2913, 2914, 2915, 2916, 4432, 2917, 2918, 2919,
+	2920, 2921, 2922, 4443, 5494, 5495, 5496, 5497, 5498, 5499, 5500, 7390, 5039, 1383, 1603, 5105,
+	1384, 317, 1604, 1823, 1824, 5171, 1385, 318, 1605, 319, 320, 1825, 2043, 2044, 2045, 5237,
+	1386, 321, 1606, 322, 323, 1826, 324, 325, 326, 2046, 2263, 2264, 2265, 2266, 5303, 1387,
+	327, 1607, 328, 329, 1827, 330, 331, 332, 2047, 333, 334, 335, 336, 2267, 2483, 2484,
+	2485, 2486, 2487, 5369, 1388, 337, 1608, 338, 339, 1828, 340, 341, 342, 2048, 343, 344,
+	345, 346, 2268, 347, 348, 349, 350, 351, 2488, 2703, 2704, 2705, 2706, 2707, 2708, 5435,
+	1389, 352, 1609, 353, 354, 1829, 355, 356, 357, 2049, 358, 359, 360, 361, 2269, 362,
+	363, 364, 365, 366, 2489, 367, 368, 369, 370, 371, 372, 2709, 2923, 2924, 2925, 2926,
+	2927, 2928, 2929, 5501, 4454, 3122, 4465, 3123, 3124, 4476, 3125, 3126, 3127, 4487, 3128, 3129,
+	3130, 3131, 4498, 3132, 3133, 3134, 3135, 3136, 4509, 3137, 3138, 3139, 3140, 3141, 3142, 4520,
+	3143, 3144, 3145, 3146, 3147, 3148, 3149, 4531, 5560, 5561, 5562, 5563, 5564, 5565, 5566, 5567,
+	7402, 5040, 1390, 1610, 5106, 1391, 373, 1611, 1830, 1831, 5172, 1392, 374, 1612, 375, 376,
+	1832, 2050, 2051, 2052, 5238, 1393, 377, 1613, 378, 379, 1833, 380, 381, 382, 2053, 2270,
+	2271, 2272, 2273, 5304, 1394, 383, 1614, 384, 385, 1834, 386, 387, 388, 2054, 389, 390,
+	391, 392, 2274, 2490, 2491, 2492, 2493, 2494, 5370, 1395, 393, 1615, 394, 395, 1835, 396,
+	397, 398, 2055, 399, 400, 401, 402, 2275, 403, 404, 405, 406, 407, 2495, 2710, 2711,
+	2712, 2713, 2714, 2715, 5436, 1396, 408, 1616, 409, 410, 1836, 411, 412, 413, 2056, 414,
+	415, 416, 417, 2276, 418, 419, 420, 421, 422, 2496, 423, 424, 425, 426, 427, 428,
+	2716, 2930, 2931, 2932, 2933, 2934, 2935, 2936, 5502, 1397, 429, 1617, 430, 431, 1837, 432,
+	433, 434, 2057, 435, 436, 437, 438, 2277, 439, 440, 441, 442, 443, 2497, 444, 445,
+	446, 447, 448, 449, 2717, 450, 451, 452, 453, 454, 455, 5861, 2937, 3150, 3151, 3152,
+	3153, 3154, 3155, 3156, 3157, 5568, 4542, 3342, 4553, 3343, 3344, 4564, 3345, 3346, 3347, 4575,
+	3348, 3349, 3350, 3351, 4586, 3352, 3353, 3354, 3355, 3356, 4597, 3357, 3358, 3359, 3360, 3361,
+	3362, 4608, 3363, 3364, 3365, 3366, 3367, 3368, 3369, 4619, 3370, 3371, 3372, 3373, 3374, 3375,
+	3376, 3377, 4630, 5626, 5627, 5628, 5629, 5630, 5631, 5632, 5633, 5634, 7414, 7150, 4633, 4644,
+	7162, 4634, 3478, 4645, 4655, 4656, 7174, 4635, 3479, 4646, 3480, 3481, 4657, 4666, 4667, 4668,
+	7186, 4636, 3482, 4647, 3483, 3484, 4658, 3485, 3486, 3487, 4669, 4677, 4678, 4679, 4680, 7198,
+	4637, 3488, 4648, 3489, 3490, 4659, 3491, 3492, 3493, 4670, 3494, 3495, 3496, 3497, 4681, 4688,
+	4689, 4690, 4691, 4692, 7210, 4638, 3498, 4649, 3499, 3500, 4660, 3501, 3502, 3503, 4671, 3504,
+	3505, 3506, 3507, 4682, 3508, 3509, 3510, 3511, 3512, 4693, 4699, 4700, 4701, 4702, 4703, 4704,
+	7222, 4639, 3513, 4650, 3514, 3515, 4661, 3516, 3517, 3518, 4672, 3519, 3520, 3521, 3522, 4683,
+	3523, 3524, 3525, 3526, 3527, 4694, 3528, 3529, 3530, 3531, 3532, 3533, 4705, 4710, 4711, 4712,
+	4713, 4714, 4715, 4716, 7234, 4640, 3534, 4651, 3535, 3536, 4662, 3537, 3538, 3539, 4673, 3540,
+	3541, 3542, 3543, 4684, 3544, 3545, 3546, 3547, 3548, 4695, 3549, 3550, 3551, 3552, 3553, 3554,
+	4706, 3555, 3556, 3557, 3558, 3559, 3560, 3561, 4717, 4721, 4722, 4723, 4724, 4725, 4726, 4727,
+	4728, 7246, 4641, 3562, 4652, 3563, 3564, 4663, 3565, 3566, 3567, 4674, 3568, 3569, 3570, 3571,
+	4685, 3572, 3573, 3574, 3575, 3576, 4696, 3577, 3578, 3579, 3580, 3581, 3582, 4707, 3583, 3584,
+	3585, 3586, 3587, 3588, 3589, 4718, 3590, 3591, 3592, 3593, 3594, 3595, 3596, 3597, 4729, 4732,
+	4733, 4734, 4735, 4736, 4737, 4738, 4739, 4740, 7258, 7261, 5656, 7262, 5657, 5658, 7263, 5659,
+	5660, 5661, 7264, 5662, 5663, 5664, 5665, 7265, 5666, 5667, 5668, 5669, 5670, 7266, 5671, 5672,
+	5673, 5674, 5675, 5676, 7267, 5677, 5678, 5679, 5680, 5681, 5682, 5683, 7268, 5684, 5685, 5686,
+	5687, 5688, 5689, 5690, 5691, 7269, 5692, 5693, 5694, 5695, 5696, 5697, 5698, 5699, 5700, 7270,
+	7417, 7418, 7419, 7420, 7421, 7422, 7423, 7424, 7425, 7426, 0, 7307, 5041, 4147, 5107, 7319,
+	5042, 1398, 1618, 5108, 4158, 1838, 4169, 5173, 5174, 7331, 5043, 1399, 1619, 5109, 1400, 456,
+	1620, 1839, 1840, 5175, 4180, 2058, 4191, 2059, 2060, 4202, 5239, 5240, 5241, 7343, 5044, 1401,
+	1621, 5110, 1402, 457, 1622, 1841, 1842, 5176, 1403, 458, 1623, 459, 460, 1843, 2061, 2062,
+	2063, 5242, 4213, 2278, 4224, 2279, 2280, 4235, 2281, 2282, 2283, 4246, 5305, 5306, 5307, 5308,
+	7355, 5045, 1404, 1624, 5111, 1405, 461, 1625, 1844, 1845, 5177, 1406, 462, 1626, 463, 464,
+	1846, 2064, 2065, 2066, 5243, 1407, 465, 1627, 466, 467, 1847, 468, 469, 470, 2067, 2284,
+	2285, 2286, 2287, 5309, 4257, 2498, 4268, 2499, 2500, 4279, 2501, 2502, 2503, 4290, 2504, 2505,
+	2506, 2507, 4301, 5371, 5372, 5373, 5374, 5375, 7367, 5046, 1408, 1628, 5112, 1409, 471, 1629,
+	1848, 1849, 5178, 1410, 472, 1630, 473, 474, 1850, 2068, 2069, 2070, 5244, 1411, 475, 1631,
+	476, 477, 1851, 478, 479, 480, 2071, 2288, 2289, 2290, 2291, 5310, 1412, 481, 1632, 482,
+	483, 1852, 484, 485, 486, 2072, 487, 488, 489, 490, 2292, 2508, 2509, 2510, 2511, 2512,
+	5376, 4312, 2718, 4323, 2719, 2720, 4334, 2721, 2722, 2723, 4345, 2724, 2725, 2726, 2727, 4356,
+	2728, 2729, 2730, 2731, 2732, 4367, 5437, 5438, 5439, 5440, 5441, 5442, 7379, 5047, 1413, 1633,
+	5113, 1414, 491, 1634, 1853, 1854, 5179, 1415, 492, 1635, 493, 494, 1855, 2073, 2074, 2075,
+	5245, 1416, 495, 1636, 496, 497, 1856, 498, 499, 500, 2076, 2293, 2294, 2295, 2296, 5311,
+	1417, 501, 1637, 502, 503, 1857, 504, 505, 506, 2077, 507, 508, 509, 510, 2297, 2513,
+	2514, 2515, 2516, 2517, 5377, 1418, 511, 1638, 512, 513, 1858, 514, 515, 516, 2078, 517,
+	518, 519, 520, 2298, 521, 522, 523, 524, 525, 2518, 2733, 2734, 2735, 2736, 2737, 2738,
+	5443, 4378, 2938, 4389, 2939, 2940, 4400, 2941, 2942, 2943, 4411, 2944, 2945, 2946, 2947, 4422,
+	2948, 2949, 2950, 2951, 2952, 4433, 2953, 2954, 2955, 2956, 2957, 2958, 4444, 5503, 5504, 5505,
+	5506, 5507, 5508, 5509, 7391, 5048, 1419, 1639, 5114, 1420, 526, 1640, 1859, 1860, 5180, 1421,
+	527, 1641, 528, 529, 1861, 2079, 2080, 2081, 5246, 1422, 530, 1642, 531, 532, 1862, 533,
+	534, 535, 2082, 2299, 2300, 2301, 2302, 5312, 1423, 536, 1643, 537, 538, 1863, 539, 540,
+	541, 2083, 542, 543, 544, 545, 2303, 2519, 2520, 2521, 2522, 2523, 5378, 1424, 546, 1644,
+	547, 548, 1864, 549, 550, 551, 2084, 552, 553, 554, 555, 2304, 556, 557, 558, 559,
+	560, 2524, 2739, 2740, 2741, 2742, 2743, 2744, 5444, 1425, 561, 1645, 562, 563, 1865, 564,
+	565, 566, 2085, 567, 568, 569, 570, 2305, 571, 572, 573, 574, 575, 2525, 576, 577,
+	578, 579, 580, 581, 2745, 2959, 2960, 2961, 2962, 2963, 2964, 2965, 5510, 4455, 3158, 4466,
+	3159, 3160, 4477, 3161, 3162, 3163, 4488, 3164, 3165, 3166, 3167, 4499, 3168, 3169, 3170, 3171,
+	3172, 4510, 3173, 3174, 3175, 3176, 3177, 3178, 4521, 3179, 3180, 3181, 3182, 3183, 3184, 3185,
+	4532, 5569, 5570, 5571, 5572, 5573, 5574, 5575, 5576, 7403, 5049, 1426, 1646, 5115, 1427, 582,
+	1647, 1866, 1867, 5181, 1428, 583, 1648, 584, 585, 1868, 2086, 2087, 2088, 5247, 1429, 586,
+	1649, 587, 588, 1869, 589, 590, 591, 2089, 2306, 2307, 2308, 2309, 5313, 1430, 592, 1650,
+	593, 594, 1870, 595, 596, 597, 2090, 598, 599, 600, 601, 2310, 2526, 2527, 2528, 2529,
+	2530, 5379, 1431, 602, 1651, 603, 604, 1871, 605, 606, 607, 2091, 608, 609, 610, 611,
+	2311, 612, 613, 614, 615, 616, 2531, 2746, 2747, 2748, 2749, 2750, 2751, 5445, 1432, 617,
+	1652, 618, 619, 1872, 620, 621, 622, 2092, 623, 624, 625, 626, 2312, 627, 628, 629,
+	630, 631, 2532, 632, 633, 634, 635, 636, 637, 2752, 2966, 2967, 2968, 2969, 2970, 2971,
+	2972, 5511, 1433, 638, 1653, 639, 640, 1873, 641, 642, 643, 2093, 644, 645, 646, 647,
+	2313, 648, 649, 650, 651, 652, 2533, 653, 654, 655, 656, 657, 658, 2753, 659, 660,
+	661, 662, 663, 664, 665, 2973, 3186, 3187, 3188, 3189, 3190, 3191, 3192, 3193, 5577, 4543,
+	3378, 4554, 3379, 3380, 4565, 3381, 3382, 3383, 4576, 3384, 3385, 3386, 3387, 4587, 3388, 3389,
+	3390, 3391, 3392, 4598, 3393, 3394, 3395, 3396, 3397, 3398, 4609, 3399, 3400, 3401, 3402, 3403,
+	3404, 3405, 4620, 3406, 3407, 3408, 3409, 3410, 3411, 3412, 3413, 4631, 5635, 5636, 5637, 5638,
+	5639, 5640, 5641, 5642, 5643, 7415, 5050, 1434, 1654, 5116, 1435, 666, 1655, 1874, 1875, 5182,
+	1436, 667, 1656, 668, 669, 1876, 2094, 2095, 2096, 5248, 1437, 670, 1657, 671, 672, 1877,
+	673, 674, 675, 2097, 2314, 2315, 2316, 2317, 5314, 1438, 676, 1658, 677, 678, 1878, 679,
+	680, 681, 2098, 682, 683, 684, 685, 2318, 2534, 2535, 2536, 2537, 2538, 5380, 1439, 686,
+	1659, 687, 688, 1879, 689, 690, 691, 2099, 692, 693, 694, 695, 2319, 696, 697, 698,
+	699, 700, 2539, 2754, 2755, 2756, 2757, 2758, 2759, 5446, 1440, 701, 1660, 702, 703, 1880,
+	704, 705, 706, 2100, 707, 708, 709, 710, 2320, 711, 712, 713, 714, 715, 2540, 716,
+	717, 718, 719, 720, 721, 2760, 2974, 2975, 2976, 2977, 2978, 2979, 2980, 5512, 1441, 722,
+	1661, 723, 724, 1881, 725, 726, 727, 2101, 728, 729, 730, 731, 2321, 732, 733, 734,
+	735, 736, 2541, 737, 738, 739, 740, 741, 742, 2761, 743, 744, 745, 746, 747, 748,
+	749, 2981, 3194, 3195, 3196, 3197, 3198, 3199, 3200, 3201, 5578, 1442, 750, 1662, 751, 752,
+	1882, 753, 754, 755, 2102, 756, 757, 758, 759, 2322, 760, 761, 762, 763, 764, 2542,
+	765, 766, 767, 768, 769, 770, 2762, 771, 772, 773, 774, 775, 776, 777, 2982, 778,
+	779, 780, 781, 782, 783, 784, 5862, 3202, 3414, 3415, 3416, 3417, 3418, 3419, 3420, 3421,
+	3422, 5644, 4642, 3598, 4653, 3599, 3600, 4664, 3601, 3602, 3603, 4675, 3604, 3605, 3606, 3607,
+	4686, 3608, 3609, 3610, 3611, 3612, 4697, 3613, 3614, 3615, 3616, 3617, 3618, 4708, 3619, 3620,
+	3621, 3622, 3623, 3624, 3625, 4719, 3626, 3627, 3628, 3629, 3630, 3631, 3632, 3633, 4730, 3634,
+	3635, 3636, 3637, 3638, 3639, 3640, 3641, 3642, 4741, 5701, 5702, 5703, 5704, 5705, 5706, 5707,
+	5708, 5709, 5710, 7427, 7151, 4743, 4754, 7163, 4744, 3698, 4755, 4765, 4766, 7175, 4745, 3699,
+	4756, 3700, 3701, 4767, 4776, 4777, 4778, 7187, 4746, 3702, 4757, 3703, 3704, 4768, 3705, 3706,
+	3707, 4779, 4787, 4788, 4789, 4790, 7199, 4747, 3708, 4758, 3709, 3710, 4769, 3711, 3712, 3713,
+	4780, 3714, 3715, 3716, 3717, 4791, 4798, 4799, 4800, 4801, 4802, 7211, 4748, 3718, 4759, 3719,
+	3720, 4770, 3721, 3722, 3723, 4781, 3724, 3725, 3726, 3727, 4792, 3728, 3729, 3730, 3731, 3732,
+	4803, 4809, 4810, 4811, 4812, 4813, 4814, 7223, 4749, 3733, 4760, 3734, 3735, 4771, 3736, 3737,
+	3738, 4782, 3739, 3740, 3741, 3742, 4793, 3743, 3744, 3745, 3746, 3747, 4804, 3748, 3749, 3750,
+	3751, 3752, 3753, 4815, 4820, 4821, 4822, 4823, 4824, 4825, 4826, 7235, 4750, 3754, 4761, 3755,
+	3756, 4772, 3757, 3758, 3759, 4783, 3760, 3761, 3762, 3763, 4794, 3764, 3765, 3766, 3767, 3768,
+	4805, 3769, 3770, 3771, 3772, 3773, 3774, 4816, 3775, 3776, 3777, 3778, 3779, 3780, 3781, 4827,
+	4831, 4832, 4833, 4834, 4835, 4836, 4837, 4838, 7247, 4751, 3782, 4762, 3783, 3784, 4773, 3785,
+	3786, 3787, 4784, 3788, 3789, 3790, 3791, 4795, 3792, 3793, 3794, 3795, 3796, 4806, 3797, 3798,
+	3799, 3800, 3801, 3802, 4817, 3803, 3804, 3805, 3806, 3807, 3808, 3809, 4828, 3810, 3811, 3812,
+	3813, 3814, 3815, 3816, 3817, 4839, 4842, 4843, 4844, 4845, 4846, 4847, 4848, 4849, 4850, 7259,
+	4752, 3818, 4763, 3819, 3820, 4774, 3821, 3822, 3823, 4785, 3824, 3825, 3826, 3827, 4796, 3828,
+	3829, 3830, 3831, 3832, 4807, 3833, 3834, 3835, 3836, 3837, 3838, 4818, 3839, 3840, 3841, 3842,
+	3843, 3844, 3845, 4829, 3846, 3847, 3848, 3849, 3850, 3851, 3852, 3853, 4840, 3854, 3855, 3856,
+	3857, 3858, 3859, 3860, 3861, 3862, 4851, 4853, 4854, 4855, 4856, 4857, 4858, 4859, 4860, 4861,
+	4862, 7271, 7273, 5722, 7274, 5723, 5724, 7275, 5725, 5726, 5727, 7276, 5728, 5729, 5730, 5731,
+	7277, 5732, 5733, 5734, 5735, 5736, 7278, 5737, 5738, 5739, 5740, 5741, 5742, 7279, 5743, 5744,
+	5745, 5746, 5747, 5748, 5749, 7280, 5750, 5751, 5752, 5753, 5754, 5755, 5756, 5757, 7281, 5758,
+	5759, 5760, 5761, 5762, 5763, 5764, 5765, 5766, 7282, 5767, 5768, 5769, 5770, 5771, 5772, 5773,
+	5774, 5775, 5776, 7283, 7429, 7430, 7431, 7432, 7433, 7434, 7435, 7436, 7437, 7438, 7439, 0,
+	7308, 5051, 4148, 5117, 7320, 5052, 1443, 1663, 5118, 4159, 1883, 4170, 5183, 5184, 7332, 5053,
+	1444, 1664, 5119, 1445, 5854, 1665, 1884, 1885, 5185, 4181, 2103, 4192, 2104, 2105, 4203, 5249,
+	5250, 5251, 7344, 5054, 1446, 1666, 5120, 1447, 785, 1667, 1886, 1887, 5186, 1448, 786, 1668,
+	787, 788, 1888, 2106, 2107, 2108, 5252, 4214, 2323, 4225, 2324, 2325, 4236, 2326, 2327, 2328,
+	4247, 5315, 5316, 5317, 5318, 7356, 5055, 1449, 1669, 5121, 1450, 789, 1670, 1889, 1890, 5187,
+	1451, 790, 1671, 791, 792, 1891, 2109, 2110, 2111, 5253, 1452, 793, 1672, 794, 795, 1892,
+	796, 797, 798, 2112, 2329, 2330, 2331, 2332, 5319, 4258, 2543, 4269, 2544, 2545, 4280, 2546,
+	2547, 2548, 4291, 2549, 2550, 2551, 2552, 4302, 5381, 5382, 5383, 5384, 5385, 7368, 5056, 1453,
+	1673, 5122, 1454, 799, 1674, 1893, 1894, 5188, 1455, 800, 1675, 801, 802, 1895, 2113, 2114,
+	2115, 5254, 1456, 803, 1676, 804, 805, 1896, 806, 807, 808, 2116, 2333, 2334, 2335, 2336,
+	5320, 1457, 809, 1677, 810, 811, 1897, 812, 813, 814, 2117, 815, 816, 817, 818, 2337,
+	2553, 2554, 2555, 2556, 2557, 5386, 4313, 2763, 4324, 2764, 2765, 4335, 2766, 2767, 2768, 4346,
+	2769, 2770, 2771, 2772, 4357, 2773, 2774, 2775, 2776, 2777, 4368, 5447, 5448, 5449, 5450, 5451,
+	5452, 7380, 5057, 1458, 1678, 5123, 1459, 819, 1679, 1898, 1899, 5189, 1460, 820, 1680, 821,
+	822, 1900, 2118, 2119, 2120, 5255, 1461, 823, 1681, 824, 825, 1901, 826, 827, 828, 2121,
+	2338, 2339, 2340, 2341, 5321, 1462, 829, 1682, 830, 831, 1902, 832, 833, 834, 2122, 835,
+	836, 837, 838, 2342, 2558, 2559, 2560, 2561, 2562, 5387, 1463, 839, 1683, 840, 841, 1903,
+	842, 843, 844, 2123, 845, 846, 847, 848, 2343, 849, 850, 851, 852, 853, 2563, 2778,
+	2779, 2780, 2781, 2782, 2783, 5453, 4379, 2983, 4390, 2984, 2985, 4401, 2986, 2987, 2988, 4412,
+	2989, 2990, 2991, 2992, 4423, 2993, 2994, 2995, 2996, 2997, 4434, 2998, 2999, 3000, 3001, 3002,
+	3003, 4445, 5513, 5514, 5515, 5516, 5517, 5518, 5519, 7392, 5058, 1464, 1684, 5124, 1465, 854,
+	1685, 1904, 1905, 5190, 1466, 855, 1686, 856, 857, 1906, 2124, 2125, 2126, 5256, 1467, 858,
+	1687, 859, 860, 1907, 861, 862, 863, 2127, 2344, 2345, 2346, 2347, 5322, 1468, 864, 1688,
+	865, 866, 1908, 867, 868, 869, 2128, 870, 871, 872, 873, 2348, 2564, 2565, 2566, 2567,
+	2568, 5388, 1469, 874, 1689, 875, 876, 1909, 877, 878, 879, 2129, 880, 881, 882, 883,
+	2349, 884, 885, 886, 887, 888, 2569, 2784, 2785, 2786, 2787, 2788, 2789, 5454, 1470, 889,
+	1690, 890, 891, 1910, 892, 893, 894, 2130, 895, 896, 897, 898, 2350, 899, 900, 901,
+	902, 903, 2570, 904, 905, 906, 907, 908, 909, 2790, 3004, 3005, 3006, 3007, 3008, 3009,
+	3010, 5520, 4456, 3203, 4467, 3204, 3205, 4478, 3206, 3207, 3208, 4489, 3209, 3210, 3211, 3212,
+	4500, 3213, 3214, 3215, 3216, 3217, 4511, 3218, 3219, 3220, 3221, 3222, 3223, 4522, 3224, 3225,
+	3226, 3227, 3228, 3229, 3230, 4533, 5579, 5580, 5581, 5582, 5583, 5584, 5585, 5586, 7404, 5059,
+	1471, 1691, 5125, 1472, 910, 1692, 1911, 1912, 5191, 1473, 911, 1693, 912, 913, 1913, 2131,
+	2132, 2133, 5257, 1474, 914, 1694, 915, 916, 1914, 917, 918, 919, 2134, 2351, 2352, 2353,
+	2354, 5323, 1475, 920, 1695, 921, 922, 1915, 923, 924, 925, 2135, 926, 927, 928, 929,
+	2355, 2571, 2572, 2573, 2574, 2575, 5389, 1476, 930, 1696, 931, 932, 1916, 933, 934, 935,
+	2136, 936, 937, 938, 939, 2356, 940, 941, 942, 943, 944, 2576, 2791, 2792, 2793, 2794,
+	2795, 2796, 5455, 1477, 945, 1697, 946, 947, 1917, 948, 949, 950, 2137, 951, 952, 953,
+	954, 2357, 955, 956, 957, 958, 959, 2577, 960, 961, 962, 963, 964, 965, 2797, 3011,
+	3012, 3013, 3014, 3015, 3016, 3017, 5521, 1478, 966, 1698, 967, 968, 1918, 969, 970, 971,
+	2138, 972, 973, 974, 975, 2358, 976, 977, 978, 979, 980, 2578, 981, 982, 983, 984,
+	985, 986, 2798, 987, 988, 989, 990, 991, 992, 993, 3018, 3231, 3232, 3233, 3234, 3235,
+	3236, 3237, 3238, 5587, 4544, 3423, 4555, 3424, 3425, 4566, 3426, 3427, 3428, 4577, 3429, 3430,
+	3431, 3432, 4588, 3433, 3434, 3435, 3436, 3437, 4599, 3438, 3439, 3440, 3441, 3442, 3443, 4610,
+	3444, 3445, 3446, 3447, 3448, 3449, 3450, 4621, 3451, 3452, 3453, 3454, 3455, 3456, 3457, 3458,
+	4632, 5645, 5646, 5647, 5648, 5649, 5650, 5651, 5652, 5653, 7416, 5060, 1479, 1699, 5126, 1480,
+	994, 1700, 1919, 1920, 5192, 1481, 995, 1701, 996, 997, 1921, 2139, 2140, 2141, 5258, 1482,
+	998, 1702, 999, 1000, 1922, 1001, 1002, 1003, 2142, 2359, 2360, 2361, 2362, 5324, 1483, 1004,
+	1703, 1005, 1006, 1923, 1007, 1008, 1009, 2143, 1010, 1011, 1012, 1013, 2363, 2579, 2580, 2581,
+	2582, 2583, 5390, 1484, 1014, 1704, 1015, 1016, 1924, 1017, 1018, 1019, 2144, 1020, 1021, 1022,
+	1023, 2364, 1024, 1025, 1026, 1027, 1028, 2584, 2799, 2800, 2801, 2802, 2803, 2804, 5456, 1485,
+	1029, 1705, 1030, 1031, 1925, 1032, 1033, 1034, 2145, 1035, 1036, 1037, 1038, 2365, 1039, 1040,
+	1041, 1042, 1043, 2585, 1044, 1045, 1046, 1047, 1048, 1049, 2805, 3019, 3020, 3021, 3022, 3023,
+	3024, 3025, 5522, 1486, 1050, 1706, 1051, 1052, 1926, 1053, 1054, 1055, 2146, 1056, 1057, 1058,
+	1059, 2366, 1060, 1061, 1062, 1063, 1064, 2586, 1065, 1066, 1067, 1068, 1069, 1070, 2806, 1071,
+	1072, 1073, 1074, 1075, 1076, 1077, 3026, 3239, 3240, 3241, 3242, 3243, 3244, 3245, 3246, 5588,
+	1487, 1078, 1707, 1079, 1080, 1927, 1081, 1082, 1083, 2147, 1084, 1085, 1086, 1087, 2367, 1088,
+	1089, 1090, 1091, 1092, 2587, 1093, 1094, 1095, 1096, 1097, 1098, 2807, 1099, 1100, 1101, 1102,
+	1103, 1104, 1105, 3027, 1106, 1107, 1108, 1109, 1110, 1111, 1112, 1113, 3247, 3459, 3460, 3461,
+	3462, 3463, 3464, 3465, 3466, 3467, 5654, 4643, 3643, 4654, 3644, 3645, 4665, 3646, 3647, 3648,
+	4676, 3649, 3650, 3651, 3652, 4687, 3653, 3654, 3655, 3656, 3657, 4698, 3658, 3659, 3660, 3661,
+	3662, 3663, 4709, 3664, 3665, 3666, 3667, 3668, 3669, 3670, 4720, 3671, 3672, 3673, 3674, 3675,
+	3676, 3677, 3678, 4731, 3679, 3680, 3681, 3682, 3683, 3684, 3685, 3686, 3687, 4742, 5711, 5712,
+	5713, 5714, 5715, 5716, 5717, 5718, 5719, 5720, 7428, 5061, 1488, 1708, 5127, 1489, 1114, 1709,
+	1928, 1929, 5193, 1490, 1115, 1710, 1116, 1117, 1930, 2148, 2149, 2150, 5259, 1491, 1118, 1711,
+	1119, 1120, 1931, 1121, 1122, 1123, 2151, 2368, 2369, 2370, 2371, 5325, 1492, 1124, 1712, 1125,
+	1126, 1932, 1127, 1128, 1129, 2152, 1130, 1131, 1132, 1133, 2372, 2588, 2589, 2590, 2591, 2592,
+	5391, 1493, 1134, 1713, 1135, 1136, 1933, 1137, 1138, 1139, 2153, 1140, 1141, 1142, 1143, 2373,
+	1144, 1145, 1146, 1147, 1148, 2593, 2808, 2809, 2810, 2811, 2812, 2813, 5457, 1494, 1149, 1714,
+	1150, 1151, 1934, 1152, 1153, 1154, 2154, 1155, 1156, 1157, 1158, 2374, 1159, 1160, 1161, 1162,
+	1163, 2594, 1164, 1165, 1166, 1167, 1168, 1169, 2814, 3028, 3029, 3030, 3031, 3032, 3033, 3034,
+	5523, 1495, 1170, 1715, 1171, 1172, 1935, 1173, 1174, 1175, 2155, 1176, 1177, 1178, 1179, 2375,
+	1180, 1181, 1182, 1183, 1184, 2595, 1185, 1186, 1187, 1188, 1189, 1190, 2815, 1191, 1192, 1193,
+	1194, 1195, 1196, 1197, 3035, 3248, 3249, 3250, 3251, 3252, 3253, 3254, 3255, 5589, 1496, 1198,
+	1716, 1199, 1200, 1936, 1201, 1202, 1203, 2156, 1204, 1205, 1206, 1207, 2376, 1208, 1209, 1210,
+	1211, 1212, 2596, 1213, 1214, 1215, 1216, 1217, 1218, 2816, 1219, 1220, 1221, 1222, 1223, 1224,
+	1225, 3036, 1226, 1227, 1228, 1229, 1230, 1231, 1232, 1233, 3256, 3468, 3469, 3470, 3471, 3472,
+	3473, 3474, 3475, 3476, 5655, 1497, 1234, 1717, 1235, 1236, 1937, 1237, 1238, 1239, 2157, 1240,
+	1241, 1242, 1243, 2377, 1244, 1245, 1246, 1247, 1248, 2597, 1249, 1250, 1251, 1252, 1253, 1254,
+	2817, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 3037, 1262, 1263, 1264, 1265, 1266, 1267, 1268,
+	1269, 3257, 1270, 1271, 1272, 1273, 1274, 1275, 1276, 1277, 5863, 3477, 3688, 3689, 3690, 3691,
+	3692, 3693, 3694, 3695, 3696, 3697, 5721, 4753, 3863, 4764, 3864, 3865, 4775, 3866, 3867, 3868,
+	4786, 3869, 3870, 3871, 3872, 4797, 3873, 3874, 3875, 3876, 3877, 4808, 3878, 3879, 3880, 3881,
+	3882, 3883, 4819, 3884, 3885, 3886, 3887, 3888, 3889, 3890, 4830, 3891, 3892, 3893, 3894, 3895,
+	3896, 3897, 3898, 4841, 3899, 3900, 3901, 3902, 3903, 3904, 3905, 3906, 3907, 4852, 3908, 3909,
+	3910, 3911, 3912, 3913, 3914, 3915, 3916, 3917, 4863, 5777, 5778, 5779, 5780, 5781, 5782, 5783,
+	5784, 5785, 5786, 5787, 7440, 7152, 4864, 4875, 7164, 4865, 3918, 4876, 4886, 4887, 7176, 4866,
+	3919, 4877, 3920, 3921, 4888, 4897, 4898, 4899, 7188, 4867, 3922, 4878, 3923, 3924, 4889, 3925,
+	3926, 3927, 4900, 4908, 4909, 4910, 4911, 7200, 4868, 3928, 4879, 3929, 3930, 4890, 3931, 3932,
+	3933, 4901, 3934, 3935, 3936, 3937, 4912, 4919, 4920, 4921, 4922, 4923, 7212, 4869, 3938, 4880,
+	3939, 3940, 4891, 3941, 3942, 3943, 4902, 3944, 3945, 3946, 3947, 4913, 3948, 3949, 3950, 3951,
+	3952, 4924, 4930, 4931, 4932, 4933, 4934, 4935, 7224, 4870, 3953, 4881, 3954, 3955, 4892, 3956,
+	3957, 3958, 4903, 3959, 3960, 3961, 3962, 4914, 3963, 3964, 3965, 3966, 3967, 4925, 3968, 3969,
+	3970, 3971, 3972, 3973, 4936, 4941, 4942, 4943, 4944, 4945, 4946, 4947, 7236, 4871, 3974, 4882,
+	3975, 3976, 4893, 3977, 3978, 3979, 4904, 3980, 3981, 3982, 3983, 4915, 3984, 3985, 3986, 3987,
+	3988, 4926, 3989, 3990, 3991, 3992, 3993, 3994, 4937, 3995, 3996, 3997, 3998, 3999, 4000, 4001,
+	4948, 4952, 4953, 4954, 4955, 4956, 4957, 4958, 4959, 7248, 4872, 4002, 4883, 4003, 4004, 4894,
+	4005, 4006, 4007, 4905, 4008, 4009, 4010, 4011, 4916, 4012, 4013, 4014, 4015, 4016, 4927, 4017,
+	4018, 4019, 4020, 4021, 4022, 4938, 4023, 4024, 4025, 4026, 4027, 4028, 4029, 4949, 4030, 4031,
+	4032, 4033, 4034, 4035, 4036, 4037, 4960, 4963, 4964, 4965, 4966, 4967, 4968, 4969, 4970, 4971,
+	7260, 4873, 4038, 4884, 4039, 4040, 4895, 4041, 4042, 4043, 4906, 4044, 4045, 4046, 4047, 4917,
+	4048, 4049, 4050, 4051, 4052, 4928, 4053, 4054, 4055, 4056, 4057, 4058, 4939, 4059, 4060, 4061,
+	4062, 4063, 4064, 4065, 4950, 4066, 4067, 4068, 4069, 4070, 4071, 4072, 4073, 4961, 4074, 4075,
+	4076, 4077, 4078, 4079, 4080, 4081, 4082, 4972, 4974, 4975, 4976, 4977, 4978, 4979, 4980, 4981,
+	4982, 4983, 7272, 4874, 4083, 4885, 4084, 4085, 4896, 4086, 4087, 4088, 4907, 4089, 4090, 4091,
+	4092, 4918, 4093, 4094, 4095, 4096, 4097, 4929, 4098, 4099, 4100, 4101, 4102, 4103, 4940, 4104,
+	4105, 4106, 4107, 4108, 4109, 4110, 4951, 4111, 4112, 4113, 4114, 4115, 4116, 4117, 4118, 4962,
+	4119, 4120, 4121, 4122, 4123, 4124, 4125, 4126, 4127, 4973, 4128, 4129, 4130, 4131, 4132, 4133,
+	4134, 4135, 4136, 4137, 4984, 4985, 4986, 4987, 4988, 4989, 4990, 4991, 4992, 4993, 4994, 4995,
+	7284, 7285, 5788, 7286, 5789, 5790, 7287, 5791, 5792, 5793, 7288, 5794, 5795, 5796, 5797, 7289,
+	5798, 5799, 5800, 5801, 5802, 7290, 5803, 5804, 5805, 5806, 5807, 5808, 7291, 5809, 5810, 5811,
+	5812, 5813, 5814, 5815, 7292, 5816, 5817, 5818, 5819, 5820, 5821, 5822, 5823, 7293, 5824, 5825,
+	5826, 5827, 5828, 5829, 5830, 5831, 5832, 7294, 5833, 5834, 5835, 5836, 5837, 5838, 5839, 5840,
+	5841, 5842, 7295, 5843, 5844, 5845, 5846, 5847, 5848, 5849, 5850, 5851, 5852, 5853, 7296, 7441,
+	7442, 7443, 7444, 7445, 7446, 7447, 7448, 7449, 7450, 7451, 7452, 0,
+}
+
+// high5FlushTable holds Lookup5CardHigh classes of 5-card flushes, by rankIndex
+var high5FlushTable = [6188]uint16{
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7454, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 5864, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 5865, 0, 0, 0, 0, 0, 5866, 0, 5867, 7455,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	5868, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 5869, 0, 0, 0, 0, 0, 5870, 0, 5871, 5872, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 5873, 0, 0, 0, 0, 0, 5874, 0, 5875,
+	5876, 0, 0, 0, 0, 0, 0, 5877, 0, 5878, 5879, 0, 5880, 5881, 7456, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 5882, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 5883, 0, 0, 0, 0, 0, 5884, 0, 5885, 5886,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 5887, 0, 0, 0, 0, 0, 5888,
+	0, 5889, 5890, 0, 0, 0, 0, 0, 0, 5891, 0, 5892, 5893, 0, 5894, 5895,
+	5896, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 5897, 0, 0, 0, 0, 0, 5898, 0, 5899, 5900, 0, 0, 0, 0, 0,
+	0, 5901, 0, 5902, 5903, 0, 5904, 5905, 5906, 0, 0, 0, 0, 0, 0, 0,
+	5907, 0, 5908, 5909, 0, 5910, 5911, 5912, 0, 5913, 5914, 5915, 7457, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5916, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	5917, 0, 0, 0, 0, 0, 5918, 0, 5919, 5920, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 5921, 0, 0, 0, 0, 0, 5922, 0, 5923, 5924, 0, 0, 0,
+	0, 0, 0, 5925, 0, 5926, 5927, 0, 5928, 5929, 5930, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5931, 0, 0, 0, 0,
+	0, 5932, 0, 5933, 5934, 0, 0, 0, 0, 0, 0, 5935, 0, 5936, 5937, 0,
+	5938, 5939, 5940, 0, 0, 0, 0, 0, 0, 0, 5941, 0, 5942, 5943, 0, 5944,
+	5945, 5946, 0, 5947, 5948, 5949, 5950, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5951,
+	0, 0, 0, 0, 0, 5952, 0, 5953, 5954, 0, 0, 0, 0, 0, 0, 5955,
+	0, 5956, 5957, 0, 5958, 5959, 5960, 0, 0, 0, 0, 0, 0, 0, 5961, 0,
+	5962, 5963, 0, 5964, 5965, 5966, 0, 5967, 5968, 5969, 5970, 0, 0, 0, 0, 0,
+	0, 0, 0, 5971, 0, 5972, 5973, 0, 5974, 5975, 5976, 0, 5977, 5978, 5979, 5980,
+	0, 5981, 5982, 5983, 5984, 7458, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5985, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5986,
+	0, 0, 0, 0, 0, 5987, 0, 5988, 5989, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 5990, 0, 0, 0, 0, 0, 5991, 0, 5992, 5993, 0, 0, 0, 0,
+	0, 0, 5994, 0, 5995, 5996, 0, 5997, 5998, 5999, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6000, 0, 0, 0, 0, 0,
+	6001, 0, 6002, 6003, 0, 0, 0, 0, 0, 0, 6004, 0, 6005, 6006, 0, 6007,
+	6008, 6009, 0, 0, 0, 0, 0, 0, 0, 6010, 0, 6011, 6012, 0, 6013, 6014,
+	6015, 0, 6016, 6017, 6018, 6019, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6020, 0,
+	0, 0, 0, 0, 6021, 0, 6022, 6023, 0, 0, 0, 0, 0, 0, 6024, 0,
+	6025, 6026, 0, 6027, 6028, 6029, 0, 0, 0, 0, 0, 0, 0, 6030, 0, 6031,
+	6032, 0, 6033, 6034, 6035, 0, 6036, 6037, 6038, 6039, 0, 0, 0, 0, 0, 0,
+	0, 0, 6040, 0, 6041, 6042, 0, 6043, 6044, 6045, 0, 6046, 6047, 6048, 6049, 0,
+	6050, 6051, 6052, 6053, 6054, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 6055, 0, 0, 0, 0, 0, 6056, 0, 6057, 6058,
+	0, 0, 0, 0, 0, 0, 6059, 0, 6060, 6061, 0, 6062, 6063, 6064, 0, 0,
+	0, 0, 0, 0, 0, 6065, 0, 6066, 6067, 0, 6068, 6069, 6070, 0, 6071, 6072,
+	6073, 6074, 0, 0, 0, 0, 0, 0, 0, 0, 6075, 0, 6076, 6077, 0, 6078,
+	6079, 6080, 0, 6081, 6082, 6083, 6084, 0, 6085, 6086, 6087, 6088, 6089, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 6090, 0, 6091, 6092, 0, 6093, 6094, 6095, 0, 6096,
+	6097, 6098, 6099, 0, 6100, 6101, 6102, 6103, 6104, 0, 6105, 6106, 6107, 6108, 6109, 7459,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 6110, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6111, 0, 0, 0, 0, 0,
+	6112, 0, 6113, 6114, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6115, 0, 0,
+	0, 0, 0, 6116, 0, 6117, 6118, 0, 0, 0, 0, 0, 0, 6119, 0, 6120,
+	6121, 0, 6122, 6123, 6124, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 6125, 0, 0, 0, 0, 0, 6126, 0, 6127, 6128, 0,
+	0, 0, 0, 0, 0, 6129, 0, 6130, 6131, 0, 6132, 6133, 6134, 0, 0, 0,
+	0, 0, 0, 0, 6135, 0, 6136, 6137, 0, 6138, 6139, 6140, 0, 6141, 6142, 6143,
+	6144, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 6145, 0, 0, 0, 0, 0, 6146,
+	0, 6147, 6148, 0, 0, 0, 0, 0, 0, 6149, 0, 6150, 6151, 0, 6152, 6153,
+	6154, 0, 0, 0, 0, 0, 0, 0, 6155, 0, 6156, 6157, 0, 6158, 6159, 6160,
+	0, 6161, 6162, 6163, 6164, 0, 0, 0, 0, 0, 0, 0, 0, 6165, 0, 6166,
+	6167, 0, 6168, 6169, 6170, 0, 6171, 6172, 6173, 6174, 0, 6175, 6176, 6177, 6178, 6179,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 6180, 0, 0, 0, 0, 0, 6181, 0, 6182, 6183, 0, 0, 0, 0, 0,
+	0, 6184, 0, 6185, 6186, 0, 6187, 6188, 6189, 0, 0, 0, 0, 0, 0, 0,
+	6190, 0, 6191, 6192, 0, 6193, 6194, 6195, 0, 6196, 6197, 6198, 6199, 0, 0, 0,
+	0, 0, 0, 0, 0, 6200, 0, 6201, 6202, 0, 6203, 6204, 6205, 0, 6206, 6207,
+	6208, 6209, 0, 6210, 6211, 6212, 6213, 6214, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 6215, 0, 6216, 6217, 0, 6218, 6219, 6220, 0, 6221, 6222, 6223, 6224, 0, 6225,
+	6226, 6227, 6228, 6229, 0, 6230, 6231, 6232, 6233, 6234, 6235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 6236, 0, 0, 0, 0, 0, 6237, 0, 6238, 6239,
+	0, 0, 0, 0, 0, 0, 6240, 0, 6241, 6242, 0, 6243, 6244, 6245, 0, 0,
+	0, 0, 0, 0, 0, 6246, 0, 6247, 6248, 0, 6249, 6250, 6251, 0, 6252, 6253,
+	6254, 6255, 0, 0, 0, 0, 0, 0, 0, 0, 6256, 0, 6257, 6258, 0, 6259,
+	6260, 6261, 0, 6262, 6263, 6264, 6265, 0, 6266, 6267, 6268, 6269, 6270, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 6271, 0, 6272, 6273, 0, 6274, 6275, 6276, 0, 6277,
+	6278, 6279, 6280, 0, 6281, 6282, 6283, 6284, 6285, 0, 6286, 6287, 6288, 6289, 6290, 6291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6292, 0, 6293, 6294, 0, 6295,
+	6296, 6297, 0, 6298, 6299, 6300, 6301, 0, 6302, 6303, 6304, 6305, 6306, 0, 6307, 6308,
+	6309, 6310, 6311, 6312, 0, 6313, 6314, 6315, 6316, 6317, 6318, 7460, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6319,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 6320, 0, 0, 0, 0, 0, 6321, 0, 6322, 6323, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 6324, 0, 0, 0, 0, 0, 6325, 0, 6326, 6327,
+	0, 0, 0, 0, 0, 0, 6328, 0, 6329, 6330, 0, 6331, 6332, 6333, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6334, 0,
+	0, 0, 0, 0, 6335, 0, 6336, 6337, 0, 0, 0, 0, 0, 0, 6338, 0,
+	6339, 6340, 0, 6341, 6342, 6343, 0, 0, 0, 0, 0, 0, 0, 6344, 0, 6345,
+	6346, 0, 6347, 6348, 6349, 0, 6350, 6351, 6352, 6353, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 6354, 0, 0, 0, 0, 0, 6355, 0, 6356, 6357, 0, 0, 0, 0,
+	0, 0, 6358, 0, 6359, 6360, 0, 6361, 6362, 6363, 0, 0, 0, 0, 0, 0,
+	0, 6364, 0, 6365, 6366, 0, 6367, 6368, 6369, 0, 6370, 6371, 6372, 6373, 0, 0,
+	0, 0, 0, 0, 0, 0, 6374, 0, 6375, 6376, 0, 6377, 6378, 6379, 0, 6380,
+	6381, 6382, 6383, 0, 6384, 6385, 6386, 6387, 6388, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6389, 0, 0, 0, 0, 0,
+	6390, 0, 6391, 6392, 0, 0, 0, 0, 0, 0, 6393, 0, 6394, 6395, 0, 6396,
+	6397, 6398, 0, 0, 0, 0, 0, 0, 0, 6399, 0, 6400, 6401, 0, 6402, 6403,
+	6404, 0, 6405, 6406, 6407, 6408, 0, 0, 0, 0, 0, 0, 0, 0, 6409, 0,
+	6410, 6411, 0, 6412, 6413, 6414, 0, 6415, 6416, 6417, 6418, 0, 6419, 6420, 6421, 6422,
+	6423, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6424, 0, 6425, 6426, 0, 6427,
+	6428, 6429, 0, 6430, 6431, 6432, 6433, 0, 6434, 6435, 6436, 6437, 6438, 0, 6439, 6440,
+	6441, 6442, 6443, 6444, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6445,
+	0, 0, 0, 0, 0, 6446, 0, 6447, 6448, 0, 0, 0, 0, 0, 0, 6449,
+	0, 6450, 6451, 0, 6452, 6453, 6454, 0, 0, 0, 0, 0, 0, 0, 6455, 0,
+	6456, 6457, 0, 6458, 6459, 6460, 0, 6461, 6462, 6463, 6464, 0, 0, 0, 0, 0,
+	0, 0, 0, 6465, 0, 6466, 6467, 0, 6468, 6469, 6470, 0, 6471, 6472, 6473, 6474,
+	0, 6475, 6476, 6477, 6478, 6479, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6480,
+	0, 6481, 6482, 0, 6483, 6484, 6485, 0, 6486, 6487, 6488, 6489, 0, 6490, 6491, 6492,
+	6493, 6494, 0, 6495, 6496, 6497, 6498, 6499, 6500, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 6501, 0, 6502, 6503, 0, 6504, 6505, 6506, 0, 6507, 6508, 6509, 6510,
+	0, 6511, 6512, 6513, 6514, 6515, 0, 6516, 6517, 6518, 6519, 6520, 6521, 0, 6522, 6523,
+	6524, 6525, 6526, 6527, 6528, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6529, 0, 0, 0, 0,
+	0, 6530, 0, 6531, 6532, 0, 0, 0, 0, 0, 0, 6533, 0, 6534, 6535, 0,
+	6536, 6537, 6538, 0, 0, 0, 0, 0, 0, 0, 6539, 0, 6540, 6541, 0, 6542,
+	6543, 6544, 0, 6545, 6546, 6547, 6548, 0, 0, 0, 0, 0, 0, 0, 0, 6549,
+	0, 6550, 6551, 0, 6552, 6553, 6554, 0, 6555, 6556, 6557, 6558, 0, 6559, 6560, 6561,
+	6562, 6563, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6564, 0, 6565, 6566, 0,
+	6567, 6568, 6569, 0, 6570, 6571, 6572, 6573, 0, 6574, 6575, 6576, 6577, 6578, 0, 6579,
+	6580, 6581, 6582, 6583, 6584, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6585,
+	0, 6586, 6587, 0, 6588, 6589, 6590, 0, 6591, 6592, 6593, 6594, 0, 6595, 6596, 6597,
+	6598, 6599, 0, 6600, 6601, 6602, 6603, 6604, 6605, 0, 6606, 6607, 6608, 6609, 6610, 6611,
+	6612, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6613, 0, 6614, 6615,
+	0, 6616, 6617, 6618, 0, 6619, 6620, 6621, 6622, 0, 6623, 6624, 6625, 6626, 6627, 0,
+	6628, 6629, 6630, 6631, 6632, 6633, 0, 6634, 6635, 6636, 6637, 6638, 6639, 6640, 0, 6641,
+	6642, 6643, 6644, 6645, 6646, 6647, 7461, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 7453, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 6648, 0, 0, 0, 0, 0, 6649, 0,
+	6650, 6651, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6652, 0, 0, 0, 0,
+	0, 6653, 0, 6654, 6655, 0, 0, 0, 0, 0, 0, 6656, 0, 6657, 6658, 0,
+	6659, 6660, 6661, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 6662, 0, 0, 0, 0, 0, 6663, 0, 6664, 6665, 0, 0, 0,
+	0, 0, 0, 6666, 0, 6667, 6668, 0, 6669, 6670, 6671, 0, 0, 0, 0, 0,
+	0, 0, 6672, 0, 6673, 6674, 0, 6675, 6676, 6677, 0, 6678, 6679, 6680, 6681, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 6682, 0, 0, 0, 0, 0, 6683, 0, 6684,
+	6685, 0, 0, 0, 0, 0, 0, 6686, 0, 6687, 6688, 0, 6689, 6690, 6691, 0,
+	0, 0, 0, 0, 0, 0, 6692, 0, 6693, 6694, 0, 6695, 6696, 6697, 0, 6698,
+	6699, 6700, 6701, 0, 0, 0, 0, 0, 0, 0, 0, 6702, 0, 6703, 6704, 0,
+	6705, 6706, 6707, 0, 6708, 6709, 6710, 6711, 0, 6712, 6713, 6714, 6715, 6716, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6717,
+	0, 0, 0, 0, 0, 6718, 0, 6719, 6720, 0, 0, 0, 0, 0, 0, 6721,
+	0, 6722, 6723, 0, 6724, 6725, 6726, 0, 0, 0, 0, 0, 0, 0, 6727, 0,
+	6728, 6729, 0, 6730, 6731, 6732, 0, 6733, 6734, 6735, 6736, 0, 0, 0, 0, 0,
+	0, 0, 0, 6737, 0, 6738, 6739, 0, 6740, 6741, 6742, 0, 6743, 6744, 6745, 6746,
+	0, 6747, 6748, 6749, 6750, 6751, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6752,
+	0, 6753, 6754, 0, 6755, 6756, 6757, 0, 6758, 6759, 6760, 6761, 0, 6762, 6763, 6764,
+	6765, 6766, 0, 6767, 6768, 6769, 6770, 6771, 6772, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 6773, 0, 0, 0, 0, 0, 6774, 0, 6775, 6776, 0, 0,
+	0, 0, 0, 0, 6777, 0, 6778, 6779, 0, 6780, 6781, 6782, 0, 0, 0, 0,
+	0, 0, 0, 6783, 0, 6784, 6785, 0, 6786, 6787, 6788, 0, 6789, 6790, 6791, 6792,
+	0, 0, 0, 0, 0, 0, 0, 0, 6793, 0, 6794, 6795, 0, 6796, 6797, 6798,
+	0, 6799, 6800, 6801, 6802, 0, 6803, 6804, 6805, 6806, 6807, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 6808, 0, 6809, 6810, 0, 6811, 6812, 6813, 0, 6814, 6815, 6816,
+	6817, 0, 6818, 6819, 6820, 6821, 6822, 0, 6823, 6824, 6825, 6826, 6827, 6828, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 6829, 0, 6830, 6831, 0, 6832, 6833, 6834,
+	0, 6835, 6836, 6837, 6838, 0, 6839, 6840, 6841, 6842, 6843, 0, 6844, 6845, 6846, 6847,
+	6848, 6849, 0, 6850, 6851, 6852, 6853, 6854, 6855, 6856, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	6857, 0, 0, 0, 0, 0, 6858, 0, 6859, 6860, 0, 0, 0, 0, 0, 0,
+	6861, 0, 6862, 6863, 0, 6864, 6865, 6866, 0, 0, 0, 0, 0, 0, 0, 6867,
+	0, 6868, 6869, 0, 6870, 6871, 6872, 0, 6873, 6874, 6875, 6876, 0, 0, 0, 0,
+	0, 0, 0, 0, 6877, 0, 6878, 6879, 0, 6880, 6881, 6882, 0, 6883, 6884, 6885,
+	6886, 0, 6887, 6888, 6889, 6890, 6891, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	6892, 0, 6893, 6894, 0, 6895, 6896, 6897, 0, 6898, 6899, 6900, 6901, 0, 6902, 6903,
+	6904, 6905, 6906, 0, 6907, 6908, 6909, 6910, 6911, 6912, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 6913, 0, 6914, 6915, 0, 6916, 6917, 6918, 0, 6919, 6920, 6921,
+	6922, 0, 6923, 6924, 6925, 6926, 6927, 0, 6928, 6929, 6930, 6931, 6932, 6933, 0, 6934,
+	6935, 6936, 6937, 6938, 6939, 6940, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 6941, 0, 6942, 6943, 0, 6944, 6945, 6946, 0, 6947, 6948, 6949, 6950, 0, 6951,
+	6952, 6953, 6954, 6955, 0, 6956, 6957, 6958, 6959, 6960, 6961, 0, 6962, 6963, 6964, 6965,
+	6966, 6967, 6968, 0, 6969, 6970, 6971, 6972, 6973, 6974, 6975, 6976, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6977, 0,
+	0, 0, 0, 0, 6978, 0, 6979, 6980, 0, 0, 0, 0, 0, 0, 6981, 0,
+	6982, 6983, 0, 6984, 6985, 6986, 0, 0, 0, 0, 0, 0, 0, 6987, 0, 6988,
+	6989, 0, 6990, 6991, 6992, 0, 6993, 6994, 6995, 6996, 0, 0, 0, 0, 0, 0,
+	0, 0, 6997, 0, 6998, 6999, 0, 7000, 7001, 7002, 0, 7003, 7004, 7005, 7006, 0,
+	7007, 7008, 7009, 7010, 7011, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7012, 0,
+	7013, 7014, 0, 7015, 7016, 7017, 0, 7018, 7019, 7020, 7021, 0, 7022, 7023, 7024, 7025,
+	7026, 0, 7027, 7028, 7029, 7030, 7031, 7032, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 7033, 0, 7034, 7035, 0, 7036, 7037, 7038, 0, 7039, 7040, 7041, 7042, 0,
+	7043, 7044, 7045, 7046, 7047, 0, 7048, 7049, 7050, 7051, 7052, 7053, 0, 7054, 7055, 7056,
+	7057, 7058, 7059, 7060, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7061,
+	0, 7062, 7063, 0, 7064, 7065, 7066, 0, 7067, 7068, 7069, 7070, 0, 7071, 7072, 7073,
+	7074, 7075, 0, 7076, 7077, 7078, 7079, 7080, 7081, 0, 7082, 7083, 7084, 7085, 7086, 7087,
+	7088, 0, 7089, 7090, 7091, 7092, 7093, 7094, 7095, 7096, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 7097, 0, 7098, 7099, 0, 7100, 7101, 7102, 0, 7103,
+	7104, 7105, 7106, 0, 7107, 7108, 7109, 7110, 7111, 0, 7112, 7113, 7114, 7115, 7116, 7117,
+	0, 7118, 7119, 7120, 7121, 7122, 7123, 7124, 0, 7125, 7126, 7127, 7128, 7129, 7130, 7131,
+	7132, 0, 7133, 7134, 7135, 7136, 7137, 7138, 7139, 7140, 7462, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+}
+
+// low27Table holds Lookup27Low classes of 5-card hands, by rankIndex
+var low27Table = [6188]uint16{
+	0, 165, 321, 309, 153, 0, 164, 2466, 3324, 2400, 152, 320, 3313, 3302, 308, 297,
+	2334, 296, 141, 140, 0, 163, 2465, 3323, 2399, 151, 2464, 6184, 5964, 2398, 3312, 5744,
+	3301, 2333, 2332, 139, 319, 3291, 3280, 307, 3290, 5524, 3279, 3269, 3268, 295, 285, 2268,
+	284, 2267, 2266, 283, 129, 128, 127, 0, 162, 2463, 3322, 2397, 150, 2462, 6183, 5963,
+	2396, 3311, 5743, 3300, 2331, 2330, 138, 2461, 6182, 5962, 2395, 6181, 1608, 5961, 5742, 5741,
+	2329, 3289, 5523, 3278, 5522, 5521, 3267, 2265, 2264, 2263, 126, 318, 3258, 3247, 306, 3257,
+	5304, 3246, 3236, 3235, 294, 3256, 5303, 3245, 5302, 5301, 3234, 3225, 3224, 3223, 282, 273,
+	2202, 272, 2201, 2200, 271, 2199, 2198, 2197, 270, 117, 116, 115, 114, 0, 161, 2460,
+	3321, 2394, 149, 2459, 6180, 5960, 2393, 3310, 5740, 3299, 2328, 2327, 137, 2458, 6179, 5959,
+	2392, 6178, 7462, 5958, 5739, 5738, 2326, 3288, 5520, 3277, 5519, 5518, 3266, 2262, 2261, 2260,
+	125, 2457, 6177, 5957, 2391, 6176, 7461, 5956, 5737, 5736, 2325, 6175, 7460, 5955, 7459, 1607,
+	5735, 5517, 5516, 5515, 2259, 3255, 5300, 3244, 5299, 5298, 3233, 5297, 5296, 5295, 3222, 2196,
+	2195, 2194, 2193, 113, 317, 3214, 3203, 305, 3213, 5084, 3202, 3192, 3191, 293, 3212, 5083,
+	3201, 5082, 5081, 3190, 3181, 3180, 3179, 281, 3211, 5080, 3200, 5079, 5078, 3189, 5077, 5076,
+	5075, 3178, 3170, 3169, 3168, 3167, 269, 261, 2136, 260, 2135, 2134, 259, 2133, 2132, 2131,
+	258, 2130, 2129, 2128, 2127, 257, 105, 104, 103, 102, 101, 0, 160, 2456, 3320, 2390,
+	148, 2455, 6174, 5954, 2389, 3309, 5734, 3298, 2324, 2323, 136, 2454, 6173, 5953, 2388, 6172,
+	7458, 5952, 5733, 5732, 2322, 3287, 5514, 3276, 5513, 5512, 3265, 2258, 2257, 2256, 124, 2453,
+	6171, 5951, 2387, 6170, 7457, 5950, 5731, 5730, 2321, 6169, 7456, 5949, 7455, 7454, 5729, 5511,
+	5510, 5509, 2255, 3254, 5294, 3243, 5293, 5292, 3232, 5291, 5290, 5289, 3221, 2192, 2191, 2190,
+	2189, 112, 2452, 6168, 5948, 2386, 6167, 7453, 5947, 5728, 5727, 2320, 6166, 7452, 5946, 7451,
+	7450, 5726, 5508, 5507, 5506, 2254, 6165, 7449, 5945, 7448, 7447, 5725, 7446, 7445, 1606, 5505,
+	5288, 5287, 5286, 5285, 2188, 3210, 5074, 3199, 5073, 5072, 3188, 5071, 5070, 5069, 3177, 5068,
+	5067, 5066, 5065, 3166, 2126, 2125, 2124, 2123, 2122, 100, 316, 3159, 3148, 304, 3158, 4864,
+	3147, 3137, 3136, 292, 3157, 4863, 3146, 4862, 4861, 3135, 3126, 3125, 3124, 280, 3156, 4860,
+	3145, 4859, 4858, 3134, 4857, 4856, 4855, 3123, 3115, 3114, 3113, 3112, 268, 3155, 4854, 3144,
+	4853, 4852, 3133, 4851, 4850, 4849, 3122, 4848, 4847, 4846, 4845, 3111, 3104, 3103, 3102, 3101,
+	3100, 256, 249, 2070, 248, 2069, 2068, 247, 2067, 2066, 2065, 246, 2064, 2063, 2062, 2061,
+	245, 2060, 2059, 2058, 2057, 2056, 244, 93, 92, 91, 90, 89, 88, 0, 159, 2451,
+	3319, 2385, 147, 2450, 6164, 5944, 2384, 3308, 5724, 3297, 2319, 2318, 135, 2449, 6163, 5943,
+	2383, 6162, 7444, 5942, 5723, 5722, 2317, 3286, 5504, 3275, 5503, 5502, 3264, 2253, 2252, 2251,
+	123, 2448, 6161, 5941, 2382, 6160, 7443, 5940, 5721, 5720, 2316, 6159, 7442, 5939, 7441, 7440,
+	5719, 5501, 5500, 5499, 2250, 3253, 5284, 3242, 5283, 5282, 3231, 5281, 5280, 5279, 3220, 2187,
+	2186, 2185, 2184, 111, 2447, 6158, 5938, 2381, 6157, 7439, 5937, 5718, 5717, 2315, 6156, 7438,
+	5936, 7437, 7436, 5716, 5498, 5497, 5496, 2249, 6155, 7435, 5935, 7434, 7433, 5715, 7432, 7431,
+	7430, 5495, 5278, 5277, 5276, 5275, 2183, 3209, 5064, 3198, 5063, 5062, 3187, 5061, 5060, 5059,
+	3176, 5058, 5057, 5056, 5055, 3165, 2121, 2120, 2119, 2118, 2117, 99, 2446, 6154, 5934, 2380,
+	6153, 7429, 5933, 5714, 5713, 2314, 6152, 7428, 5932, 7427, 7426, 5712, 5494, 5493, 5492, 2248,
+	6151, 7425, 5931, 7424, 7423, 5711, 7422, 7421, 7420, 5491, 5274, 5273, 5272, 5271, 2182, 6150,
+	7419, 5930, 7418, 7417, 5710, 7416, 7415, 7414, 5490, 7413, 7412, 7411, 1605, 5270, 5054, 5053,
+	5052, 5051, 5050, 2116, 3154, 4844, 3143, 4843, 4842, 3132, 4841, 4840, 4839, 3121, 4838, 4837,
+	4836, 4835, 3110, 4834, 4833, 4832, 4831, 4830, 3099, 2055, 2054, 2053, 2052, 2051, 2050, 87,
+	315, 3093, 3082, 303, 3092, 4644, 3081, 3071, 3070, 291, 3091, 4643, 3080, 4642, 4641, 3069,
+	3060, 3059, 3058, 279, 3090, 4640, 3079, 4639, 4638, 3068, 4637, 4636, 4635, 3057, 3049, 3048,
+	3047, 3046, 267, 3089, 4634, 3078, 4633, 4632, 3067, 4631, 4630, 4629, 3056, 4628, 4627, 4626,
+	4625, 3045, 3038, 3037, 3036, 3035, 3034, 255, 3088, 4624, 3077, 4623, 4622, 3066, 4621, 4620,
+	4619, 3055, 4618, 4617, 4616, 4615, 3044, 4614, 4613, 4612, 4611, 4610, 3033, 3027, 3026, 3025,
+	3024, 3023, 3022, 243, 237, 2004, 236, 2003, 2002, 235, 2001, 2000, 1999, 234, 1998, 1997,
+	1996, 1995, 233, 1994, 1993, 1992, 1991, 1990, 232, 1989, 1988, 1987, 1986, 1985, 1984, 231,
+	81, 80, 79, 78, 77, 76, 75, 0, 158, 2445, 3318, 2379, 146, 2444, 6149, 5929,
+	2378, 3307, 5709, 3296, 2313, 2312, 134, 2443, 6148, 5928, 2377, 6147, 7410, 5927, 5708, 5707,
+	2311, 3285, 5489, 3274, 5488, 5487, 3263, 2247, 2246, 2245, 122, 2442, 6146, 5926, 2376, 6145,
+	7409, 5925, 5706, 5705, 2310, 6144, 7408, 5924, 7407, 7406, 5704, 5486, 5485, 5484, 2244, 3252,
+	5269, 3241, 5268, 5267, 3230, 5266, 5265, 5264, 3219, 2181, 2180, 2179, 2178, 110, 2441, 6143,
+	5923, 2375, 6142, 7405, 5922, 5703, 5702, 2309, 6141, 7404, 5921, 7403, 7402, 5701, 5483, 5482,
+	5481, 2243, 6140, 7401, 5920, 7400, 7399, 5700, 7398, 7397, 7396, 5480, 5263, 5262, 5261, 5260,
+	2177, 3208, 5049, 3197, 5048, 5047, 3186, 5046, 5045, 5044, 3175, 5043, 5042, 5041, 5040, 3164,
+	2115, 2114, 2113, 2112, 2111, 98, 2440, 6139, 5919, 2374, 6138, 7395, 5918, 5699, 5698, 2308,
+	6137, 7394, 5917, 7393, 7392, 5697, 5479, 5478, 5477, 2242, 6136, 7391, 5916, 7390, 7389, 5696,
+	7388, 7387, 7386, 5476, 5259, 5258, 5257, 5256, 2176, 6135, 7385, 5915, 7384, 7383, 5695, 7382,
+	7381, 7380, 5475, 7379, 7378, 7377, 7376, 5255, 5039, 5038, 5037, 5036, 5035, 2110, 3153, 4829,
+	3142, 4828, 4827, 3131, 4826, 4825, 4824, 3120, 4823, 4822, 4821, 4820, 3109, 4819, 4818, 4817,
+	4816, 4815, 3098, 2049, 2048, 2047, 2046, 2045, 2044, 86, 2439, 6134, 5914, 2373, 6133, 7375,
+	5913, 5694, 5693, 2307, 6132, 7374, 5912, 7373, 7372, 5692, 5474, 5473, 5472, 2241, 6131, 7371,
+	5911, 7370, 7369, 5691, 7368, 7367, 7366, 5471, 5254, 5253, 5252, 5251, 2175, 6130, 7365, 5910,
+	7364, 7363, 5690, 7362, 7361, 7360, 5470, 7359, 7358, 7357, 7356, 5250, 5034, 5033, 5032, 5031,
+	5030, 2109, 6129, 7355, 5909, 7354, 7353, 5689, 7352, 7351, 7350, 5469, 7349, 7348, 7347, 7346,
+	5249, 7345, 7344, 7343, 7342, 1604, 5029, 4814, 4813, 4812, 4811, 4810, 4809, 2043, 3087, 4609,
+	3076, 4608, 4607, 3065, 4606, 4605, 4604, 3054, 4603, 4602, 4601, 4600, 3043, 4599, 4598, 4597,
+	4596, 4595, 3032, 4594, 4593, 4592, 4591, 4590, 4589, 3021, 1983, 1982, 1981, 1980, 1979, 1978,
+	1977, 74, 314, 3016, 3005, 302, 3015, 4424, 3004, 2994, 2993, 290, 3014, 4423, 3003, 4422,
+	4421, 2992, 2983, 2982, 2981, 278, 3013, 4420, 3002, 4419, 4418, 2991, 4417, 4416, 4415, 2980,
+	2972, 2971, 2970, 2969, 266, 3012, 4414, 3001, 4413, 4412, 2990, 4411, 4410, 4409, 2979, 4408,
+	4407, 4406, 4405, 2968, 2961, 2960, 2959, 2958, 2957, 254, 3011, 4404, 3000, 4403, 4402, 2989,
+	4401, 4400, 4399, 2978, 4398, 4397, 4396, 4395, 2967, 4394, 4393, 4392, 4391, 4390, 2956, 2950,
+	2949, 2948, 2947, 2946, 2945, 242, 3010, 4389, 2999, 4388, 4387, 2988, 4386, 4385, 4384, 2977,
+	4383, 4382, 4381, 4380, 2966, 4379, 4378, 4377, 4376, 4375, 2955, 4374, 4373, 4372, 4371, 4370,
+	4369, 2944, 2939, 2938, 2937, 2936, 2935, 2934, 2933, 230, 225, 1938, 224, 1937, 1936, 223,
+	1935, 1934, 1933, 222, 1932, 1931, 1930, 1929, 221, 1928, 1927, 1926, 1925, 1924, 220, 1923,
+	1922, 1921, 1920, 1919, 1918, 219, 1917, 1916, 1915, 1914, 1913, 1912, 1911, 218, 69, 68,
+	67, 66, 65, 64, 63, 62, 0, 157, 2438, 3317, 2372, 145, 2437, 6128, 5908, 2371,
+	3306, 5688, 3295, 2306, 2305, 133, 2436, 6127, 5907, 2370, 6126, 7341, 5906, 5687, 5686, 2304,
+	3284, 5468, 3273, 5467, 5466, 3262, 2240, 2239, 2238, 121, 2435, 6125, 5905, 2369, 6124, 7340,
+	5904, 5685, 5684, 2303, 6123, 7339, 5903, 7338, 7337, 5683, 5465, 5464, 5463, 2237, 3251, 5248,
+	3240, 5247, 5246, 3229, 5245, 5244, 5243, 3218, 2174, 2173, 2172, 2171, 109, 2434, 6122, 5902,
+	2368, 6121, 7336, 5901, 5682, 5681, 2302, 6120, 7335, 5900, 7334, 7333, 5680, 5462, 5461, 5460,
+	2236, 6119, 7332, 5899, 7331, 7330, 5679, 7329, 7328, 7327, 5459, 5242, 5241, 5240, 5239, 2170,
+	3207, 5028, 3196, 5027, 5026, 3185, 5025, 5024, 5023, 3174, 5022, 5021, 5020, 5019, 3163, 2108,
+	2107, 2106, 2105, 2104, 97, 2433, 6118, 5898, 2367, 6117, 7326, 5897, 5678, 5677, 2301, 6116,
+	7325, 5896, 7324, 7323, 5676, 5458, 5457, 5456, 2235, 6115, 7322, 5895, 7321, 7320, 5675, 7319,
+	7318, 7317, 5455, 5238, 5237, 5236, 5235, 2169, 6114, 7316, 5894, 7315, 7314, 5674, 7313, 7312,
+	7311, 5454, 7310, 7309, 7308, 7307, 5234, 5018, 5017, 5016, 5015, 5014, 2103, 3152, 4808, 3141,
+	4807, 4806, 3130, 4805, 4804, 4803, 3119, 4802, 4801, 4800, 4799, 3108, 4798, 4797, 4796, 4795,
+	4794, 3097, 2042, 2041, 2040, 2039, 2038, 2037, 85, 2432, 6113, 5893, 2366, 6112, 7306, 5892,
+	5673, 5672, 2300, 6111, 7305, 5891, 7304, 7303, 5671, 5453, 5452, 5451, 2234, 6110, 7302, 5890,
+	7301, 7300, 5670, 7299, 7298, 7297, 5450, 5233, 5232, 5231, 5230, 2168, 6109, 7296, 5889, 7295,
+	7294, 5669, 7293, 7292, 7291, 5449, 7290, 7289, 7288, 7287, 5229, 5013, 5012, 5011, 5010, 5009,
+	2102, 6108, 7286, 5888, 7285, 7284, 5668, 7283, 7282, 7281, 5448, 7280, 7279, 7278, 7277, 5228,
+	7276, 7275, 7274, 7273, 7272, 5008, 4793, 4792, 4791, 4790, 4789, 4788, 2036, 3086, 4588, 3075,
+	4587, 4586, 3064, 4585, 4584, 4583, 3053, 4582, 4581, 4580, 4579, 3042, 4578, 4577, 4576, 4575,
+	4574, 3031, 4573, 4572, 4571, 4570, 4569, 4568, 3020, 1976, 1975, 1974, 1973, 1972, 1971, 1970,
+	73, 2431, 6107, 5887, 2365, 6106, 7271, 5886, 5667, 5666, 2299, 6105, 7270, 5885, 7269, 7268,
+	5665, 5447, 5446, 5445, 2233, 6104, 7267, 5884, 7266, 7265, 5664, 7264, 7263, 7262, 5444, 5227,
+	5226, 5225, 5224, 2167, 6103, 7261, 5883, 7260, 7259, 5663, 7258, 7257, 7256, 5443, 7255, 7254,
+	7253, 7252, 5223, 5007, 5006, 5005, 5004, 5003, 2101, 6102, 7251, 5882, 7250, 7249, 5662, 7248,
+	7247, 7246, 5442, 7245, 7244, 7243, 7242, 5222, 7241, 7240, 7239, 7238, 7237, 5002, 4787, 4786,
+	4785, 4784, 4783, 4782, 2035, 6101, 7236, 5881, 7235, 7234, 5661, 7233, 7232, 7231, 5441, 7230,
+	7229, 7228, 7227, 5221, 7226, 7225, 7224, 7223, 7222, 5001, 7221, 7220, 7219, 7218, 7217, 1603,
+	4781, 4567, 4566, 4565, 4564, 4563, 4562, 4561, 1969, 3009, 4368, 2998, 4367, 4366, 2987, 4365,
+	4364, 4363, 2976, 4362, 4361, 4360, 4359, 2965, 4358, 4357, 4356, 4355, 4354, 2954, 4353, 4352,
+	4351, 4350, 4349, 4348, 2943, 4347, 4346, 4345, 4344, 4343, 4342, 4341, 2932, 1910, 1909, 1908,
+	1907, 1906, 1905, 1904, 1903, 61, 313, 2928, 2917, 301, 2927, 4204, 2916, 2906, 2905, 289,
+	2926, 4203, 2915, 4202, 4201, 2904, 2895, 2894, 2893, 277, 2925, 4200, 2914, 4199, 4198, 2903,
+	4197, 4196, 4195, 2892, 2884, 2883, 2882, 2881, 265, 2924, 4194, 2913, 4193, 4192, 2902, 4191,
+	4190, 4189, 2891, 4188, 4187, 4186, 4185, 2880, 2873, 2872, 2871, 2870, 2869, 253, 2923, 4184,
+	2912, 4183, 4182, 2901, 4181, 4180, 4179, 2890, 4178, 4177, 4176, 4175, 2879, 4174, 4173, 4172,
+	4171, 4170, 2868, 2862, 2861, 2860, 2859, 2858, 2857, 241, 2922, 4169, 2911, 4168, 4167, 2900,
+	4166, 4165, 4164, 2889, 4163, 4162, 4161, 4160, 2878, 4159, 4158, 4157, 4156, 4155, 2867, 4154,
+	4153, 4152, 4151, 4150, 4149, 2856, 2851, 2850, 2849, 2848, 2847, 2846, 2845, 229, 2921, 4148,
+	2910, 4147, 4146, 2899, 4145, 4144, 4143, 2888, 4142, 4141, 4140, 4139, 2877, 4138, 4137, 4136,
+	4135, 4134, 2866, 4133, 4132, 4131, 4130, 4129, 4128, 2855, 4127, 4126, 4125, 4124, 4123, 4122,
+	4121, 2844, 2840, 2839, 2838, 2837, 2836, 2835, 2834, 2833, 217, 213, 1872, 212, 1871, 1870,
+	211, 1869, 1868, 1867, 210, 1866, 1865, 1864, 1863, 209, 1862, 1861, 1860, 1859, 1858, 208,
+	1857, 1856, 1855, 1854, 1853, 1852, 207, 1851, 1850, 1849, 1848, 1847, 1846, 1845, 206, 1844,
+	1843, 1842, 1841, 1840, 1839, 1838, 1837, 205, 57, 56, 55, 54, 53, 52, 51, 50,
+	49, 0, 156, 2430, 3316, 2364, 144, 2429, 6100, 5880, 2363, 3305, 5660, 3294, 2298, 2297,
+	132, 2428, 6099, 5879, 2362, 6098, 7216, 5878, 5659, 5658, 2296, 3283, 5440, 3272, 5439, 5438,
+	3261, 2232, 2231, 2230, 120, 2427, 6097, 5877, 2361, 6096, 7215, 5876, 5657, 5656, 2295, 6095,
+	7214, 5875, 7213, 7212, 5655, 5437, 5436, 5435, 2229, 3250, 5220, 3239, 5219, 5218, 3228, 5217,
+	5216, 5215, 3217, 2166, 2165, 2164, 2163, 108, 2426, 6094, 5874, 2360, 6093, 7211, 5873, 5654,
+	5653, 2294, 6092, 7210, 5872, 7209, 7208, 5652, 5434, 5433, 5432, 2228, 6091, 7207, 5871, 7206,
+	7205, 5651, 7204, 7203, 7202, 5431, 5214, 5213, 5212, 5211, 2162, 3206, 5000, 3195, 4999, 4998,
+	3184, 4997, 4996, 4995, 3173, 4994, 4993, 4992, 4991, 3162, 2100, 2099, 2098, 2097, 2096, 96,
+	2425, 6090, 5870, 2359, 6089, 7201, 5869, 5650, 5649, 2293, 6088, 7200, 5868, 7199, 7198, 5648,
+	5430, 5429, 5428, 2227, 6087, 7197, 5867, 7196, 7195, 5647, 7194, 7193, 7192, 5427, 5210, 5209,
+	5208, 5207, 2161, 6086, 7191, 5866, 7190, 7189, 5646, 7188, 7187, 7186, 5426, 7185, 7184, 7183,
+	7182, 5206, 4990, 4989, 4988, 4987, 4986, 2095, 3151, 4780, 3140, 4779, 4778, 3129, 4777, 4776,
+	4775, 3118, 4774, 4773, 4772, 4771, 3107, 4770, 4769, 4768, 4767, 4766, 3096, 2034, 2033, 2032,
+	2031, 2030, 2029, 84, 2424, 6085, 5865, 2358, 6084, 7181, 5864, 5645, 5644, 2292, 6083, 7180,
+	5863, 7179, 7178, 5643, 5425, 5424, 5423, 2226, 6082, 7177, 5862, 7176, 7175, 5642, 7174, 7173,
+	7172, 5422, 5205, 5204, 5203, 5202, 2160, 6081, 7171, 5861, 7170, 7169, 5641, 7168, 7167, 7166,
+	5421, 7165, 7164, 7163, 7162, 5201, 4985, 4984, 4983, 4982, 4981, 2094, 6080, 7161, 5860, 7160,
+	7159, 5640, 7158, 7157, 7156, 5420, 7155, 7154, 7153, 7152, 5200, 7151, 7150, 7149, 7148, 7147,
+	4980, 4765, 4764, 4763, 4762, 4761, 4760, 2028, 3085, 4560, 3074, 4559, 4558, 3063, 4557, 4556,
+	4555, 3052, 4554, 4553, 4552, 4551, 3041, 4550, 4549, 4548, 4547, 4546, 3030, 4545, 4544, 4543,
+	4542, 4541, 4540, 3019, 1968, 1967, 1966, 1965, 1964, 1963, 1962, 72, 2423, 6079, 5859, 2357,
+	6078, 7146, 5858, 5639, 5638, 2291, 6077, 7145, 5857, 7144, 7143, 5637, 5419, 5418, 5417, 2225,
+	6076, 7142, 5856, 7141, 7140, 5636, 7139, 7138, 7137, 5416, 5199, 5198, 5197, 5196, 2159, 6075,
+	7136, 5855, 7135, 7134, 5635, 7133, 7132, 7131, 5415, 7130, 7129, 7128, 7127, 5195, 4979, 4978,
+	4977, 4976, 4975, 2093, 6074, 7126, 5854, 7125, 7124, 5634, 7123, 7122, 7121, 5414, 7120, 7119,
+	7118, 7117, 5194, 7116, 7115, 7114, 7113, 7112, 4974, 4759, 4758, 4757, 4756, 4755, 4754, 2027,
+	6073, 7111, 5853, 7110, 7109, 5633, 7108, 7107, 7106, 5413, 7105, 7104, 7103, 7102, 5193, 7101,
+	7100, 7099, 7098, 7097, 4973, 7096, 7095, 7094, 7093, 7092, 7091, 4753, 4539, 4538, 4537, 4536,
+	4535, 4534, 4533, 1961, 3008, 4340, 2997, 4339, 4338, 2986, 4337, 4336, 4335, 2975, 4334, 4333,
+	4332, 4331, 2964, 4330, 4329, 4328, 4327, 4326, 2953, 4325, 4324, 4323, 4322, 4321, 4320, 2942,
+	4319, 4318, 4317, 4316, 4315, 4314, 4313, 2931, 1902, 1901, 1900, 1899, 1898, 1897, 1896, 1895,
+	60, 2422, 6072, 5852, 2356, 6071, 7090, 5851, 5632, 5631, 2290, 6070, 7089, 5850, 7088, 7087,
+	5630, 5412, 5411, 5410, 2224, 6069, 7086, 5849, 7085, 7084, 5629, 7083, 7082, 7081, 5409, 5192,
+	5191, 5190, 5189, 2158, 6068, 7080, 5848, 7079, 7078, 5628, 7077, 7076, 7075, 5408, 7074, 7073,
+	7072, 7071, 5188, 4972, 4971, 4970, 4969, 4968, 2092, 6067, 7070, 5847, 7069, 7068, 5627, 7067,
+	7066, 7065, 5407, 7064, 7063, 7062, 7061, 5187, 7060, 7059, 7058, 7057, 7056, 4967, 4752, 4751,
+	4750, 4749, 4748, 4747, 2026, 6066, 7055, 5846, 7054, 7053, 5626, 7052, 7051, 7050, 5406, 7049,
+	7048, 7047, 7046, 5186, 7045, 7044, 7043, 7042, 7041, 4966, 7040, 7039, 7038, 7037, 7036, 7035,
+	4746, 4532, 4531, 4530, 4529, 4528, 4527, 4526, 1960, 6065, 7034, 5845, 7033, 7032, 5625, 7031,
+	7030, 7029, 5405, 7028, 7027, 7026, 7025, 5185, 7024, 7023, 7022, 7021, 7020, 4965, 7019, 7018,
+	7017, 7016, 7015, 7014, 4745, 7013, 7012, 7011, 7010, 7009, 7008, 1602, 4525, 4312, 4311, 4310,
+	4309, 4308, 4307, 4306, 4305, 1894, 2920, 4120, 2909, 4119, 4118, 2898, 4117, 4116, 4115, 2887,
+	4114, 4113, 4112, 4111, 2876, 4110, 4109, 4108, 4107, 4106, 2865, 4105, 4104, 4103, 4102, 4101,
+	4100, 2854, 4099, 4098, 4097, 4096, 4095, 4094, 4093, 2843, 4092, 4091, 4090, 4089, 4088, 4087,
+	4086, 4085, 2832, 1836, 1835, 1834, 1833, 1832, 1831, 1830, 1829, 1828, 48, 312, 2829, 2818,
+	300, 2828, 3984, 2817, 2807, 2806, 288, 2827, 3983, 2816, 3982, 3981, 2805, 2796, 2795, 2794,
+	276, 2826, 3980, 2815, 3979, 3978, 2804, 3977, 3976, 3975, 2793, 2785, 2784, 2783, 2782, 264,
+	2825, 3974, 2814, 3973, 3972, 2803, 3971, 3970, 3969, 2792, 3968, 3967, 3966, 3965, 2781, 2774,
+	2773, 2772, 2771, 2770, 252, 2824, 3964, 2813, 3963, 3962, 2802, 3961, 3960, 3959, 2791, 3958,
+	3957, 3956, 3955, 2780, 3954, 3953, 3952, 3951, 3950, 2769, 2763, 2762, 2761, 2760, 2759, 2758,
+	240, 2823, 3949, 2812, 3948, 3947, 2801, 3946, 3945, 3944, 2790, 3943, 3942, 3941, 3940, 2779,
+	3939, 3938, 3937, 3936, 3935, 2768, 3934, 3933, 3932, 3931, 3930, 3929, 2757, 2752, 2751, 2750,
+	2749, 2748, 2747, 2746, 228, 2822, 3928, 2811, 3927, 3926, 2800, 3925, 3924, 3923, 2789, 3922,
+	3921, 3920, 3919, 2778, 3918, 3917, 3916, 3915, 3914, 2767, 3913, 3912, 3911, 3910, 3909, 3908,
+	2756, 3907, 3906, 3905, 3904, 3903, 3902, 3901, 2745, 2741, 2740, 2739, 2738, 2737, 2736, 2735,
+	2734, 216, 2821, 3900, 2810, 3899, 3898, 2799, 3897, 3896, 3895, 2788, 3894, 3893, 3892, 3891,
+	2777, 3890, 3889, 3888, 3887, 3886, 2766, 3885, 3884, 3883, 3882, 3881, 3880, 2755, 3879, 3878,
+	3877, 3876, 3875, 3874, 3873, 2744, 3872, 3871, 3870, 3869, 3868, 3867, 3866, 3865, 2733, 2730,
+	2729, 2728, 2727, 2726, 2725, 2724, 2723, 2722, 204, 201, 1806, 200, 1805, 1804, 199, 1803,
+	1802, 1801, 198, 1800, 1799, 1798, 1797, 197, 1796, 1795, 1794, 1793, 1792, 196, 1791, 1790,
+	1789, 1788, 1787, 1786, 195, 1785, 1784, 1783, 1782, 1781, 1780, 1779, 194, 1778, 1777, 1776,
+	1775, 1774, 1773, 1772, 1771, 193, 1770, 1769, 1768, 1767, 1766, 1765, 1764, 1763, 1762, 192,
+	45, 44, 43, 42, 41, 40, 39, 38, 37, 36, 0, 155, 2421, 3315, 2355, 143,
+	2420, 6064, 5844, 2354, 3304, 5624, 3293, 2289, 2288, 131, 2419, 6063, 5843, 2353, 6062, 7007,
+	5842, 5623, 5622, 2287, 3282, 5404, 3271, 5403, 5402, 3260, 2223, 2222, 2221, 119, 2418, 6061,
+	5841, 2352, 6060, 7006, 5840, 5621, 5620, 2286, 6059, 7005, 5839, 7004, 7003, 5619, 5401, 5400,
+	5399, 2220, 3249, 5184, 3238, 5183, 5182, 3227, 5181, 5180, 5179, 3216, 2157, 2156, 2155, 2154,
+	107, 2417, 6058, 5838, 2351, 6057, 7002, 5837, 5618, 5617, 2285, 6056, 7001, 5836, 7000, 6999,
+	5616, 5398, 5397, 5396, 2219, 6055, 6998, 5835, 6997, 6996, 5615, 6995, 6994, 6993, 5395, 5178,
+	5177, 5176, 5175, 2153, 3205, 4964, 3194, 4963, 4962, 3183, 4961, 4960, 4959, 3172, 4958, 4957,
+	4956, 4955, 3161, 2091, 2090, 2089, 2088, 2087, 95, 2416, 6054, 5834, 2350, 6053, 6992, 5833,
+	5614, 5613, 2284, 6052, 6991, 5832, 6990, 6989, 5612, 5394, 5393, 5392, 2218, 6051, 6988, 5831,
+	6987, 6986, 5611, 6985, 6984, 6983, 5391, 5174, 5173, 5172, 5171, 2152, 6050, 6982, 5830, 6981,
+	6980, 5610, 6979, 6978, 6977, 5390, 6976, 6975, 6974, 6973, 5170, 4954, 4953, 4952, 4951, 4950,
+	2086, 3150, 4744, 3139, 4743, 4742, 3128, 4741, 4740, 4739, 3117, 4738, 4737, 4736, 4735, 3106,
+	4734, 4733, 4732, 4731, 4730, 3095, 2025, 2024, 2023, 2022, 2021, 2020, 83, 2415, 6049, 5829,
+	2349, 6048, 6972, 5828, 5609, 5608, 2283, 6047, 6971, 5827, 6970, 6969, 5607, 5389, 5388, 5387,
+	2217, 6046, 6968, 5826, 6967, 6966, 5606, 6965, 6964, 6963, 5386, 5169, 5168, 5167, 5166, 2151,
+	6045, 6962, 5825, 6961, 6960, 5605, 6959, 6958, 6957, 5385, 6956, 6955, 6954, 6953, 5165, 4949,
+	4948, 4947, 4946, 4945, 2085, 6044, 6952, 5824, 6951, 6950, 5604, 6949, 6948, 6947, 5384, 6946,
+	6945, 6944, 6943, 5164, 6942, 6941, 6940, 6939, 6938, 4944, 4729, 4728, 4727, 4726, 4725, 4724,
+	2019, 3084, 4524, 3073, 4523, 4522, 3062, 4521, 4520, 4519, 3051, 4518, 4517, 4516, 4515, 3040,
+	4514, 4513, 4512, 4511, 4510, 3029, 4509, 4508, 4507, 4506, 4505, 4504, 3018, 1959, 1958, 1957,
+	1956, 1955, 1954, 1953, 71, 2414, 6043, 5823, 2348, 6042, 6937, 5822, 5603, 5602, 2282, 6041,
+	6936, 5821, 6935, 6934, 5601, 5383, 5382, 5381, 2216, 6040, 6933, 5820, 6932, 6931, 5600, 6930,
+	6929, 6928, 5380, 5163, 5162, 5161, 5160, 2150, 6039, 6927, 5819, 6926, 6925, 5599, 6924, 6923,
+	6922, 5379, 6921, 6920, 6919, 6918, 5159, 4943, 4942, 4941, 4940, 4939, 2084, 6038, 6917, 5818,
+	6916, 6915, 5598, 6914, 6913, 6912, 5378, 6911, 6910, 6909, 6908, 5158, 6907, 6906, 6905, 6904,
+	6903, 4938, 4723, 4722, 4721, 4720, 4719, 4718, 2018, 6037, 6902, 5817, 6901, 6900, 5597, 6899,
+	6898, 6897, 5377, 6896, 6895, 6894, 6893, 5157, 6892, 6891, 6890, 6889, 6888, 4937, 6887, 6886,
+	6885, 6884, 6883, 6882, 4717, 4503, 4502, 4501, 4500, 4499, 4498, 4497, 1952, 3007, 4304, 2996,
+	4303, 4302, 2985, 4301, 4300, 4299, 2974, 4298, 4297, 4296, 4295, 2963, 4294, 4293, 4292, 4291,
+	4290, 2952, 4289, 4288, 4287, 4286, 4285, 4284, 2941, 4283, 4282, 4281, 4280, 4279, 4278, 4277,
+	2930, 1893, 1892, 1891, 1890, 1889, 1888, 1887, 1886, 59, 2413, 6036, 5816, 2347, 6035, 6881,
+	5815, 5596, 5595, 2281, 6034, 6880, 5814, 6879, 6878, 5594, 5376, 5375, 5374, 2215, 6033, 6877,
+	5813, 6876, 6875, 5593, 6874, 6873, 6872, 5373, 5156, 5155, 5154, 5153, 2149, 6032, 6871, 5812,
+	6870, 6869, 5592, 6868, 6867, 6866, 5372, 6865, 6864, 6863, 6862, 5152, 4936, 4935, 4934, 4933,
+	4932, 2083, 6031, 6861, 5811, 6860, 6859, 5591, 6858, 6857, 6856, 5371, 6855, 6854, 6853, 6852,
+	5151, 6851, 6850, 6849, 6848, 6847, 4931, 4716, 4715, 4714, 4713, 4712, 4711, 2017, 6030, 6846,
+	5810, 6845, 6844, 5590, 6843, 6842, 6841, 5370, 6840, 6839, 6838, 6837, 5150, 6836, 6835, 6834,
+	6833, 6832, 4930, 6831, 6830, 6829, 6828, 6827, 6826, 4710, 4496, 4495, 4494, 4493, 4492, 4491,
+	4490, 1951, 6029, 6825, 5809, 6824, 6823, 5589, 6822, 6821, 6820, 5369, 6819, 6818, 6817, 6816,
+	5149, 6815, 6814, 6813, 6812, 6811, 4929, 6810, 6809, 6808, 6807, 6806, 6805, 4709, 6804, 6803,
+	6802, 6801, 6800, 6799, 6798, 4489, 4276, 4275, 4274, 4273, 4272, 4271, 4270, 4269, 1885, 2919,
+	4084, 2908, 4083, 4082, 2897, 4081, 4080, 4079, 2886, 4078, 4077, 4076, 4075, 2875, 4074, 4073,
+	4072, 4071, 4070, 2864, 4069, 4068, 4067, 4066, 4065, 4064, 2853, 4063, 4062, 4061, 4060, 4059,
+	4058, 4057, 2842, 4056, 4055, 4054, 4053, 4052, 4051, 4050, 4049, 2831, 1827, 1826, 1825, 1824,
+	1823, 1822, 1821, 1820, 1819, 47, 2412, 6028, 5808, 2346, 6027, 6797, 5807, 5588, 5587, 2280,
+	6026, 6796, 5806, 6795, 6794, 5586, 5368, 5367, 5366, 2214, 6025, 6793, 5805, 6792, 6791, 5585,
+	6790, 6789, 6788, 5365, 5148, 5147, 5146, 5145, 2148, 6024, 6787, 5804, 6786, 6785, 5584, 6784,
+	6783, 6782, 5364, 6781, 6780, 6779, 6778, 5144, 4928, 4927, 4926, 4925, 4924, 2082, 6023, 6777,
+	5803, 6776, 6775, 5583, 6774, 6773, 6772, 5363, 6771, 6770, 6769, 6768, 5143, 6767, 6766, 6765,
+	6764, 6763, 4923, 4708, 4707, 4706, 4705, 4704, 4703, 2016, 6022, 6762, 5802, 6761, 6760, 5582,
+	6759, 6758, 6757, 5362, 6756, 6755, 6754, 6753, 5142, 6752, 6751, 6750, 6749, 6748, 4922, 6747,
+	6746, 6745, 6744, 6743, 6742, 4702, 4488, 4487, 4486, 4485, 4484, 4483, 4482, 1950, 6021, 6741,
+	5801, 6740, 6739, 5581, 6738, 6737, 6736, 5361, 6735, 6734, 6733, 6732, 5141, 6731, 6730, 6729,
+	6728, 6727, 4921, 6726, 6725, 6724, 6723, 6722, 6721, 4701, 6720, 6719, 6718, 6717, 6716, 6715,
+	6714, 4481, 4268, 4267, 4266, 4265, 4264, 4263, 4262, 4261, 1884, 6020, 6713, 5800, 6712, 6711,
+	5580, 6710, 6709, 6708, 5360, 6707, 6706, 6705, 6704, 5140, 6703, 6702, 6701, 6700, 6699, 4920,
+	6698, 6697, 6696, 6695, 6694, 6693, 4700, 6692, 6691, 6690, 6689, 6688, 6687, 6686, 4480, 6685,
+	6684, 6683, 6682, 6681, 6680, 6679, 1601, 4260, 4048, 4047, 4046, 4045, 4044, 4043, 4042, 4041,
+	4040, 1818, 2820, 3864, 2809, 3863, 3862, 2798, 3861, 3860, 3859, 2787, 3858, 3857, 3856, 3855,
+	2776, 3854, 3853, 3852, 3851, 3850, 2765, 3849, 3848, 3847, 3846, 3845, 3844, 2754, 3843, 3842,
+	3841, 3840, 3839, 3838, 3837, 2743, 3836, 3835, 3834, 3833, 3832, 3831, 3830, 3829, 2732, 3828,
+	3827, 3826, 3825, 3824, 3823, 3822, 3821, 3820, 2721, 1761, 1760, 1759, 1758, 1757, 1756, 1755,
+	1754, 1753, 1752, 35, 311, 2719, 2708, 299, 2718, 3764, 2707, 2697, 2696, 287, 2717, 3763,
+	2706, 3762, 3761, 2695, 2686, 2685, 2684, 275, 2716, 3760, 2705, 3759, 3758, 2694, 3757, 3756,
+	3755, 2683, 2675, 2674, 2673, 2672, 263, 2715, 3754, 2704, 3753, 3752, 2693, 3751, 3750, 3749,
+	2682, 3748, 3747, 3746, 3745, 2671, 2664, 2663, 2662, 2661, 2660, 251, 2714, 3744, 2703, 3743,
+	3742, 2692, 3741, 3740, 3739, 2681, 3738, 3737, 3736, 3735, 2670, 3734, 3733, 3732, 3731, 3730,
+	2659, 2653, 2652, 2651, 2650, 2649, 2648, 239, 2713, 3729, 2702, 3728, 3727, 2691, 3726, 3725,
+	3724, 2680, 3723, 3722, 3721, 3720, 2669, 3719, 3718, 3717, 3716, 3715, 2658, 3714, 3713, 3712,
+	3711, 3710, 3709, 2647, 2642, 2641, 2640, 2639, 2638, 2637, 2636, 227, 2712, 3708, 2701, 3707,
+	3706, 2690, 3705, 3704, 3703, 2679, 3702, 3701, 3700, 3699, 2668, 3698, 3697, 3696, 3695, 3694,
+	2657, 3693, 3692, 3691, 3690, 3689, 3688, 2646, 3687, 3686, 3685, 3684, 3683, 3682, 3681, 2635,
+	2631, 2630, 2629, 2628, 2627, 2626, 2625, 2624, 215, 2711, 3680, 2700, 3679, 3678, 2689, 3677,
+	3676, 3675, 2678, 3674, 3673, 3672, 3671, 2667, 3670, 3669, 3668, 3667, 3666, 2656, 3665, 3664,
+	3663, 3662, 3661, 3660, 2645, 3659, 3658, 3657, 3656, 3655, 3654, 3653, 2634, 3652, 3651, 3650,
+	3649, 3648, 3647, 3646, 3645, 2623, 2620, 2619, 2618, 2617, 2616, 2615, 2614, 2613, 2612, 203,
+	2710, 3644, 2699, 3643, 3642, 2688, 3641, 3640, 3639, 2677, 3638, 3637, 3636, 3635, 2666, 3634,
+	3633, 3632, 3631, 3630, 2655, 3629, 3628, 3627, 3626, 3625, 3624, 2644, 3623, 3622, 3621, 3620,
+	3619, 3618, 3617, 2633, 3616, 3615, 3614, 3613, 3612, 3611, 3610, 3609, 2622, 3608, 3607, 3606,
+	3605, 3604, 3603, 3602, 3601, 3600, 2611, 2609, 2608, 2607, 2606, 2605, 2604, 2603, 2602, 2601,
+	2600, 191, 189, 1740, 188, 1739, 1738, 187, 1737, 1736, 1735, 186, 1734, 1733, 1732, 1731,
+	185, 1730, 1729, 1728, 1727, 1726, 184, 1725, 1724, 1723, 1722, 1721, 1720, 183, 1719, 1718,
+	1717, 1716, 1715, 1714, 1713, 182, 1712, 1711, 1710, 1709, 1708, 1707, 1706, 1705, 181, 1704,
+	1703, 1702, 1701, 1700, 1699, 1698, 1697, 1696, 180, 1695, 1694, 1693, 1692, 1691, 1690, 1689,
+	1688, 1687, 1686, 179, 33, 32, 31, 30, 29, 28, 27, 26, 25, 24, 23, 0,
+	154, 2411, 3314, 2345, 142, 2410, 6019, 5799, 2344, 3303, 5579, 3292, 2279, 2278, 130, 2409,
+	6018, 5798, 2343, 6017, 6678, 5797, 5578, 5577, 2277, 3281, 5359, 3270, 5358, 5357, 3259, 2213,
+	2212, 2211, 118, 2408, 6016, 5796, 2342, 6015, 6677, 5795, 5576, 5575, 2276, 6014, 6676, 5794,
+	6675, 6674, 5574, 5356, 5355, 5354, 2210, 3248, 5139, 3237, 5138, 5137, 3226, 5136, 5135, 5134,
+	3215, 2147, 2146, 2145, 2144, 106, 2407, 6013, 5793, 2341, 6012, 6673, 5792, 5573, 5572, 2275,
+	6011, 6672, 5791, 6671, 6670, 5571, 5353, 5352, 5351, 2209, 6010, 6669, 5790, 6668, 6667, 5570,
+	6666, 6665, 6664, 5350, 5133, 5132, 5131, 5130, 2143, 3204, 4919, 3193, 4918, 4917, 3182, 4916,
+	4915, 4914, 3171, 4913, 4912, 4911, 4910, 3160, 2081, 2080, 2079, 2078, 2077, 94, 2406, 6009,
+	5789, 2340, 6008, 6663, 5788, 5569, 5568, 2274, 6007, 6662, 5787, 6661, 6660, 5567, 5349, 5348,
+	5347, 2208, 6006, 6659, 5786, 6658, 6657, 5566, 6656, 6655, 6654, 5346, 5129, 5128, 5127, 5126,
+	2142, 6005, 6653, 5785, 6652, 6651, 5565, 6650, 6649, 6648, 5345, 6647, 6646, 6645, 6644, 5125,
+	4909, 4908, 4907, 4906, 4905, 2076, 3149, 4699, 3138, 4698, 4697, 3127, 4696, 4695, 4694, 3116,
+	4693, 4692, 4691, 4690, 3105, 4689, 4688, 4687, 4686, 4685, 3094, 2015, 2014, 2013, 2012, 2011,
+	2010, 82, 2405, 6004, 5784, 2339, 6003, 6643, 5783, 5564, 5563, 2273, 6002, 6642, 5782, 6641,
+	6640, 5562, 5344, 5343, 5342, 2207, 6001, 6639, 5781, 6638, 6637, 5561, 6636, 6635, 6634, 5341,
+	5124, 5123, 5122, 5121, 2141, 6000, 6633, 5780, 6632, 6631, 5560, 6630, 6629, 6628, 5340, 6627,
+	6626, 6625, 6624, 5120, 4904, 4903, 4902, 4901, 4900, 2075, 5999, 6623, 5779, 6622, 6621, 5559,
+	6620, 6619, 6618, 5339, 6617, 6616, 6615, 6614, 5119, 6613, 6612, 6611, 6610, 6609, 4899, 4684,
+	4683, 4682, 4681, 4680, 4679, 2009, 3083, 4479, 3072, 4478, 4477, 3061, 4476, 4475, 4474, 3050,
+	4473, 4472, 4471, 4470, 3039, 4469, 4468, 4467, 4466, 4465, 3028, 4464, 4463, 4462, 4461, 4460,
+	4459, 3017, 1949, 1948, 1947, 1946, 1945, 1944, 1943, 70, 2404, 5998, 5778, 2338, 5997, 6608,
+	5777, 5558, 5557, 2272, 5996, 6607, 5776, 6606, 6605, 5556, 5338, 5337, 5336, 2206, 5995, 6604,
+	5775, 6603, 6602, 5555, 6601, 6600, 6599, 5335, 5118, 5117, 5116, 5115, 2140, 5994, 6598, 5774,
+	6597, 6596, 5554, 6595, 6594, 6593, 5334, 6592, 6591, 6590, 6589, 5114, 4898, 4897, 4896, 4895,
+	4894, 2074, 5993, 6588, 5773, 6587, 6586, 5553, 6585, 6584, 6583, 5333, 6582, 6581, 6580, 6579,
+	5113, 6578, 6577, 6576, 6575, 6574, 4893, 4678, 4677, 4676, 4675, 4674, 4673, 2008, 5992, 6573,
+	5772, 6572, 6571, 5552, 6570, 6569, 6568, 5332, 6567, 6566, 6565, 6564, 5112, 6563, 6562, 6561,
+	6560, 6559, 4892, 6558, 6557, 6556, 6555, 6554, 6553, 4672, 4458, 4457, 4456, 4455, 4454, 4453,
+	4452, 1942, 3006, 4259, 2995, 4258, 4257, 2984, 4256, 4255, 4254, 2973, 4253, 4252, 4251, 4250,
+	2962, 4249, 4248, 4247, 4246, 4245, 2951, 4244, 4243, 4242, 4241, 4240, 4239, 2940, 4238, 4237,
+	4236, 4235, 4234, 4233, 4232, 2929, 1883, 1882, 1881, 1880, 1879, 1878, 1877, 1876, 58, 2403,
+	5991, 5771, 2337, 5990, 6552, 5770, 5551, 5550, 2271, 5989, 6551, 5769, 6550, 6549, 5549, 5331,
+	5330, 5329, 2205, 5988, 6548, 5768, 6547, 6546, 5548, 6545, 6544, 6543, 5328, 5111, 5110, 5109,
+	5108, 2139, 5987, 6542, 5767, 6541, 6540, 5547, 6539, 6538, 6537, 5327, 6536, 6535, 6534, 6533,
+	5107, 4891, 4890, 4889, 4888, 4887, 2073, 5986, 6532, 5766, 6531, 6530, 5546, 6529, 6528, 6527,
+	5326, 6526, 6525, 6524, 6523, 5106, 6522, 6521, 6520, 6519, 6518, 4886, 4671, 4670, 4669, 4668,
+	4667, 4666, 2007, 5985, 6517, 5765, 6516, 6515, 5545, 6514, 6513, 6512, 5325, 6511, 6510, 6509,
+	6508, 5105, 6507, 6506, 6505, 6504, 6503, 4885, 6502, 6501, 6500, 6499, 6498, 6497, 4665, 4451,
+	4450, 4449, 4448, 4447, 4446, 4445, 1941, 5984, 6496, 5764, 6495, 6494, 5544, 6493, 6492, 6491,
+	5324, 6490, 6489, 6488, 6487, 5104, 6486, 6485, 6484, 6483, 6482, 4884, 6481, 6480, 6479, 6478,
+	6477, 6476, 4664, 6475, 6474, 6473, 6472, 6471, 6470, 6469, 4444, 4231, 4230, 4229, 4228, 4227,
+	4226, 4225, 4224, 1875, 2918, 4039, 2907, 4038, 4037, 2896, 4036, 4035, 4034, 2885, 4033, 4032,
+	4031, 4030, 2874, 4029, 4028, 4027, 4026, 4025, 2863, 4024, 4023, 4022, 4021, 4020, 4019, 2852,
+	4018, 4017, 4016, 4015, 4014, 4013, 4012, 2841, 4011, 4010, 4009, 4008, 4007, 4006, 4005, 4004,
+	2830, 1817, 1816, 1815, 1814, 1813, 1812, 1811, 1810, 1809, 46, 2402, 5983, 5763, 2336, 5982,
+	6468, 5762, 5543, 5542, 2270, 5981, 6467, 5761, 6466, 6465, 5541, 5323, 5322, 5321, 2204, 5980,
+	6464, 5760, 6463, 6462, 5540, 6461, 6460, 6459, 5320, 5103, 5102, 5101, 5100, 2138, 5979, 6458,
+	5759, 6457, 6456, 5539, 6455, 6454, 6453, 5319, 6452, 6451, 6450, 6449, 5099, 4883, 4882, 4881,
+	4880, 4879, 2072, 5978, 6448, 5758, 6447, 6446, 5538, 6445, 6444, 6443, 5318, 6442, 6441, 6440,
+	6439, 5098, 6438, 6437, 6436, 6435, 6434, 4878, 4663, 4662, 4661, 4660, 4659, 4658, 2006, 5977,
+	6433, 5757, 6432, 6431, 5537, 6430, 6429, 6428, 5317, 6427, 6426, 6425, 6424, 5097, 6423, 6422,
+	6421, 6420, 6419, 4877, 6418, 6417, 6416, 6415, 6414, 6413, 4657, 4443, 4442, 4441, 4440, 4439,
+	4438, 4437, 1940, 5976, 6412, 5756, 6411, 6410, 5536, 6409, 6408, 6407, 5316, 6406, 6405, 6404,
+	6403, 5096, 6402, 6401, 6400, 6399, 6398, 4876, 6397, 6396, 6395, 6394, 6393, 6392, 4656, 6391,
+	6390, 6389, 6388, 6387, 6386, 6385, 4436, 4223, 4222, 4221, 4220, 4219, 4218, 4217, 4216, 1874,
+	5975, 6384, 5755, 6383, 6382, 5535, 6381, 6380, 6379, 5315, 6378, 6377, 6376, 6375, 5095, 6374,
+	6373, 6372, 6371, 6370, 4875, 6369, 6368, 6367, 6366, 6365, 6364, 4655, 6363, 6362, 6361, 6360,
+	6359, 6358, 6357, 4435, 6356, 6355, 6354, 6353, 6352, 6351, 6350, 6349, 4215, 4003, 4002, 4001,
+	4000, 3999, 3998, 3997, 3996, 3995, 1808, 2819, 3819, 2808, 3818, 3817, 2797, 3816, 3815, 3814,
+	2786, 3813, 3812, 3811, 3810, 2775, 3809, 3808, 3807, 3806, 3805, 2764, 3804, 3803, 3802, 3801,
+	3800, 3799, 2753, 3798, 3797, 3796, 3795, 3794, 3793, 3792, 2742, 3791, 3790, 3789, 3788, 3787,
+	3786, 3785, 3784, 2731, 3783, 3782, 3781, 3780, 3779, 3778, 3777, 3776, 3775, 2720, 1751, 1750,
+	1749, 1748, 1747, 1746, 1745, 1744, 1743, 1742, 34, 2401, 5974, 5754, 2335, 5973, 6348, 5753,
+	5534, 5533, 2269, 5972, 6347, 5752, 6346, 6345, 5532, 5314, 5313, 5312, 2203, 5971, 6344, 5751,
+	6343, 6342, 5531, 6341, 6340, 6339, 5311, 5094, 5093, 5092, 5091, 2137, 5970, 6338, 5750, 6337,
+	6336, 5530, 6335, 6334, 6333, 5310, 6332, 6331, 6330, 6329, 5090, 4874, 4873, 4872, 4871, 4870,
+	2071, 5969, 6328, 5749, 6327, 6326, 5529, 6325, 6324, 6323, 5309, 6322, 6321, 6320, 6319, 5089,
+	6318, 6317, 6316, 6315, 6314, 4869, 4654, 4653, 4652, 4651, 4650, 4649, 2005, 5968, 6313, 5748,
+	6312, 6311, 5528, 6310, 6309, 6308, 5308, 6307, 6306, 6305, 6304, 5088, 6303, 6302, 6301, 6300,
+	6299, 4868, 6298, 6297, 6296, 6295, 6294, 6293, 4648, 4434, 4433, 4432, 4431, 4430, 4429, 4428,
+	1939, 5967, 6292, 5747, 6291, 6290, 5527, 6289, 6288, 6287, 5307, 6286, 6285, 6284, 6283, 5087,
+	6282, 6281, 6280, 6279, 6278, 4867, 6277, 6276, 6275, 6274, 6273, 6272, 4647, 6271, 6270, 6269,
+	6268, 6267, 6266, 6265, 4427, 4214, 4213, 4212, 4211, 4210, 4209, 4208, 4207, 1873, 5966, 6264,
+	5746, 6263, 6262, 5526, 6261, 6260, 6259, 5306, 6258, 6257, 6256, 6255, 5086, 6254, 6253, 6252,
+	6251, 6250, 4866, 6249, 6248, 6247, 6246, 6245, 6244, 4646, 6243, 6242, 6241, 6240, 6239, 6238,
+	6237, 4426, 6236, 6235, 6234, 6233, 6232, 6231, 6230, 6229, 4206, 3994, 3993, 3992, 3991, 3990,
+	3989, 3988, 3987, 3986, 1807, 5965, 6228, 5745, 6227, 6226, 5525, 6225, 6224, 6223, 5305, 6222,
+	6221, 6220, 6219, 5085, 6218, 6217, 6216, 6215, 6214, 4865, 6213, 6212, 6211, 6210, 6209, 6208,
+	4645, 6207, 6206, 6205, 6204, 6203, 6202, 6201, 4425, 6200, 6199, 6198, 6197, 6196, 6195, 6194,
+	6193, 4205, 6192, 6191, 6190, 6189, 6188, 6187, 6186, 6185, 1600, 3985, 3774, 3773, 3772, 3771,
+	3770, 3769, 3768, 3767, 3766, 3765, 1741, 2709, 3599, 2698, 3598, 3597, 2687, 3596, 3595, 3594,
+	2676, 3593, 3592, 3591, 3590, 2665, 3589, 3588, 3587, 3586, 3585, 2654, 3584, 3583, 3582, 3581,
+	3580, 3579, 2643, 3578, 3577, 3576, 3575, 3574, 3573, 3572, 2632, 3571, 3570, 3569, 3568, 3567,
+	3566, 3565, 3564, 2621, 3563, 3562, 3561, 3560, 3559, 3558, 3557, 3556, 3555, 2610, 3554, 3553,
+	3552, 3551, 3550, 3549, 3548, 3547, 3546, 3545, 2599, 1685, 1684, 1683, 1682, 1681, 1680, 1679,
+	1678, 1677, 1676, 1675, 22, 310, 2598, 2587, 298, 2597, 3544, 2586, 2576, 2575, 286, 2596,
+	3543, 2585, 3542, 3541, 2574, 2565, 2564, 2563, 274, 2595, 3540, 2584, 3539, 3538, 2573, 3537,
+	3536, 3535, 2562, 2554, 2553, 2552, 2551, 262, 2594, 3534, 2583, 3533, 3532, 2572, 3531, 3530,
+	3529, 2561, 3528, 3527, 3526, 3525, 2550, 2543, 2542, 2541, 2540, 2539, 250, 2593, 3524, 2582,
+	3523, 3522, 2571, 3521, 3520, 3519, 2560, 3518, 3517, 3516, 3515, 2549, 3514, 3513, 3512, 3511,
+	3510, 2538, 2532, 2531, 2530, 2529, 2528, 2527, 238, 2592, 3509, 2581, 3508, 3507, 2570, 3506,
+	3505, 3504, 2559, 3503, 3502, 3501, 3500, 2548, 3499, 3498, 3497, 3496, 3495, 2537, 3494, 3493,
+	3492, 3491, 3490, 3489, 2526, 2521, 2520, 2519, 2518, 2517, 2516, 2515, 226, 2591, 3488, 2580,
+	3487, 3486, 2569, 3485, 3484, 3483, 2558, 3482, 3481, 3480, 3479, 2547, 3478, 3477, 3476, 3475,
+	3474, 2536, 3473, 3472, 3471, 3470, 3469, 3468, 2525, 3467, 3466, 3465, 3464, 3463, 3462, 3461,
+	2514, 2510, 2509, 2508, 2507, 2506, 2505, 2504, 2503, 214, 2590, 3460, 2579, 3459, 3458, 2568,
+	3457, 3456, 3455, 2557, 3454, 3453, 3452, 3451, 2546, 3450, 3449, 3448, 3447, 3446, 2535, 3445,
+	3444, 3443, 3442, 3441, 3440, 2524, 3439, 3438, 3437, 3436, 3435, 3434, 3433, 2513, 3432, 3431,
+	3430, 3429, 3428, 3427, 3426, 3425, 2502, 2499, 2498, 2497, 2496, 2495, 2494, 2493, 2492, 2491,
+	202, 2589, 3424, 2578, 3423, 3422, 2567, 3421, 3420, 3419, 2556, 3418, 3417, 3416, 3415, 2545,
+	3414, 3413, 3412, 3411, 3410, 2534, 3409, 3408, 3407, 3406, 3405, 3404, 2523, 3403, 3402, 3401,
+	3400, 3399, 3398, 3397, 2512, 3396, 3395, 3394, 3393, 3392, 3391, 3390, 3389, 2501, 3388, 3387,
+	3386, 3385, 3384, 3383, 3382, 3381, 3380, 2490, 2488, 2487, 2486, 2485, 2484, 2483, 2482, 2481,
+	2480, 2479, 190, 2588, 3379, 2577, 3378, 3377, 2566, 3376, 3375, 3374, 2555, 3373, 3372, 3371,
+	3370, 2544, 3369, 3368, 3367, 3366, 3365, 2533, 3364, 3363, 3362, 3361, 3360, 3359, 2522, 3358,
+	3357, 3356, 3355, 3354, 3353, 3352, 2511, 3351, 3350, 3349, 3348, 3347, 3346, 3345, 3344, 2500,
+	3343, 3342, 3341, 3340, 3339, 3338, 3337, 3336, 3335, 2489, 3334, 3333, 3332, 3331, 3330, 3329,
+	3328, 3327, 3326, 3325, 2478, 2477, 2476, 2475, 2474, 2473, 2472, 2471, 2470, 2469, 2468, 2467,
+	178, 177, 1674, 176, 1673, 1672, 175, 1671, 1670, 1669, 174, 1668, 1667, 1666, 1665, 173,
+	1664, 1663, 1662, 1661, 1660, 172, 1659, 1658, 1657, 1656, 1655, 1654, 171, 1653, 1652, 1651,
+	1650, 1649, 1648, 1647, 170, 1646, 1645, 1644, 1643, 1642, 1641, 1640, 1639, 169, 1638, 1637,
+	1636, 1635, 1634, 1633, 1632, 1631, 1630, 168, 1629, 1628, 1627, 1626, 1625, 1624, 1623, 1622,
+	1621, 1620, 167, 1619, 1618, 1617, 1616, 1615, 1614, 1613, 1612, 1611, 1610, 1609, 166, 21,
+	20, 19, 18, 17, 16, 15, 14, 13, 12, 11, 10, 0,
+}
+
+// low27FlushTable holds Lookup27Low classes of 5-card flushes, by rankIndex
+var low27FlushTable = [6188]uint16{
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1599, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1598, 0, 0, 0, 0, 0, 1597, 0, 1596, 8,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1595, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1594, 0, 0, 0, 0, 0, 1593, 0, 1592, 1591, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1590, 0, 0, 0, 0, 0, 1589, 0, 1588,
+	1587, 0, 0, 0, 0, 0, 0, 1586, 0, 1585, 1584, 0, 1583, 1582, 7, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1581, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1580, 0, 0, 0, 0, 0, 1579, 0, 1578, 1577,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1576, 0, 0, 0, 0, 0, 1575,
+	0, 1574, 1573, 0, 0, 0, 0, 0, 0, 1572, 0, 1571, 1570, 0, 1569, 1568,
+	1567, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1566, 0, 0, 0, 0, 0, 1565, 0, 1564, 1563, 0, 0, 0, 0, 0,
+	0, 1562, 0, 1561, 1560, 0, 1559, 1558, 1557, 0, 0, 0, 0, 0, 0, 0,
+	1556, 0, 1555, 1554, 0, 1553, 1552, 1551, 0, 1550, 1549, 1548, 6, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1547, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1546, 0, 0, 0, 0, 0, 1545, 0, 1544, 1543, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1542, 0, 0, 0, 0, 0, 1541, 0, 1540, 1539, 0, 0, 0,
+	0, 0, 0, 1538, 0, 1537, 1536, 0, 1535, 1534, 1533, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1532, 0, 0, 0, 0,
+	0, 1531, 0, 1530, 1529, 0, 0, 0, 0, 0, 0, 1528, 0, 1527, 1526, 0,
+	1525, 1524, 1523, 0, 0, 0, 0, 0, 0, 0, 1522, 0, 1521, 1520, 0, 1519,
+	1518, 1517, 0, 1516, 1515, 1514, 1513, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1512,
+	0, 0, 0, 0, 0, 1511, 0, 1510, 1509, 0, 0, 0, 0, 0, 0, 1508,
+	0, 1507, 1506, 0, 1505, 1504, 1503, 0, 0, 0, 0, 0, 0, 0, 1502, 0,
+	1501, 1500, 0, 1499, 1498, 1497, 0, 1496, 1495, 1494, 1493, 0, 0, 0, 0, 0,
+	0, 0, 0, 1492, 0, 1491, 1490, 0, 1489, 1488, 1487, 0, 1486, 1485, 1484, 1483,
+	0, 1482, 1481, 1480, 1479, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1478, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1477,
+	0, 0, 0, 0, 0, 1476, 0, 1475, 1474, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1473, 0, 0, 0, 0, 0, 1472, 0, 1471, 1470, 0, 0, 0, 0,
+	0, 0, 1469, 0, 1468, 1467, 0, 1466, 1465, 1464, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1463, 0, 0, 0, 0, 0,
+	1462, 0, 1461, 1460, 0, 0, 0, 0, 0, 0, 1459, 0, 1458, 1457, 0, 1456,
+	1455, 1454, 0, 0, 0, 0, 0, 0, 0, 1453, 0, 1452, 1451, 0, 1450, 1449,
+	1448, 0, 1447, 1446, 1445, 1444, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1443, 0,
+	0, 0, 0, 0, 1442, 0, 1441, 1440, 0, 0, 0, 0, 0, 0, 1439, 0,
+	1438, 1437, 0, 1436, 1435, 1434, 0, 0, 0, 0, 0, 0, 0, 1433, 0, 1432,
+	1431, 0, 1430, 1429, 1428, 0, 1427, 1426, 1425, 1424, 0, 0, 0, 0, 0, 0,
+	0, 0, 1423, 0, 1422, 1421, 0, 1420, 1419, 1418, 0, 1417, 1416, 1415, 1414, 0,
+	1413, 1412, 1411, 1410, 1409, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1408, 0, 0, 0, 0, 0, 1407, 0, 1406, 1405,
+	0, 0, 0, 0, 0, 0, 1404, 0, 1403, 1402, 0, 1401, 1400, 1399, 0, 0,
+	0, 0, 0, 0, 0, 1398, 0, 1397, 1396, 0, 1395, 1394, 1393, 0, 1392, 1391,
+	1390, 1389, 0, 0, 0, 0, 0, 0, 0, 0, 1388, 0, 1387, 1386, 0, 1385,
+	1384, 1383, 0, 1382, 1381, 1380, 1379, 0, 1378, 1377, 1376, 1375, 1374, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1373, 0, 1372, 1371, 0, 1370, 1369, 1368, 0, 1367,
+	1366, 1365, 1364, 0, 1363, 1362, 1361, 1360, 1359, 0, 1358, 1357, 1356, 1355, 1354, 4,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1353, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1352, 0, 0, 0, 0, 0,
+	1351, 0, 1350, 1349, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1348, 0, 0,
+	0, 0, 0, 1347, 0, 1346, 1345, 0, 0, 0, 0, 0, 0, 1344, 0, 1343,
+	1342, 0, 1341, 1340, 1339, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1338, 0, 0, 0, 0, 0, 1337, 0, 1336, 1335, 0,
+	0, 0, 0, 0, 0, 1334, 0, 1333, 1332, 0, 1331, 1330, 1329, 0, 0, 0,
+	0, 0, 0, 0, 1328, 0, 1327, 1326, 0, 1325, 1324, 1323, 0, 1322, 1321, 1320,
+	1319, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1318, 0, 0, 0, 0, 0, 1317,
+	0, 1316, 1315, 0, 0, 0, 0, 0, 0, 1314, 0, 1313, 1312, 0, 1311, 1310,
+	1309, 0, 0, 0, 0, 0, 0, 0, 1308, 0, 1307, 1306, 0, 1305, 1304, 1303,
+	0, 1302, 1301, 1300, 1299, 0, 0, 0, 0, 0, 0, 0, 0, 1298, 0, 1297,
+	1296, 0, 1295, 1294, 1293, 0, 1292, 1291, 1290, 1289, 0, 1288, 1287, 1286, 1285, 1284,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1283, 0, 0, 0, 0, 0, 1282, 0, 1281, 1280, 0, 0, 0, 0, 0,
+	0, 1279, 0, 1278, 1277, 0, 1276, 1275, 1274, 0, 0, 0, 0, 0, 0, 0,
+	1273, 0, 1272, 1271, 0, 1270, 1269, 1268, 0, 1267, 1266, 1265, 1264, 0, 0, 0,
+	0, 0, 0, 0, 0, 1263, 0, 1262, 1261, 0, 1260, 1259, 1258, 0, 1257, 1256,
+	1255, 1254, 0, 1253, 1252, 1251, 1250, 1249, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1248, 0, 1247, 1246, 0, 1245, 1244, 1243, 0, 1242, 1241, 1240, 1239, 0, 1238,
+	1237, 1236, 1235, 1234, 0, 1233, 1232, 1231, 1230, 1229, 1228, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1227, 0, 0, 0, 0, 0, 1226, 0, 1225, 1224,
+	0, 0, 0, 0, 0, 0, 1223, 0, 1222, 1221, 0, 1220, 1219, 1218, 0, 0,
+	0, 0, 0, 0, 0, 1217, 0, 1216, 1215, 0, 1214, 1213, 1212, 0, 1211, 1210,
+	1209, 1208, 0, 0, 0, 0, 0, 0, 0, 0, 1207, 0, 1206, 1205, 0, 1204,
+	1203, 1202, 0, 1201, 1200, 1199, 1198, 0, 1197, 1196, 1195, 1194, 1193, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1192, 0, 1191, 1190, 0, 1189, 1188, 1187, 0, 1186,
+	1185, 1184, 1183, 0, 1182, 1181, 1180, 1179, 1178, 0, 1177, 1176, 1175, 1174, 1173, 1172,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1171, 0, 1170, 1169, 0, 1168,
+	1167, 1166, 0, 1165, 1164, 1163, 1162, 0, 1161, 1160, 1159, 1158, 1157, 0, 1156, 1155,
+	1154, 1153, 1152, 1151, 0, 1150, 1149, 1148, 1147, 1146, 1145, 3, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1144,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1143, 0, 0, 0, 0, 0, 1142, 0, 1141, 1140, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1139, 0, 0, 0, 0, 0, 1138, 0, 1137, 1136,
+	0, 0, 0, 0, 0, 0, 1135, 0, 1134, 1133, 0, 1132, 1131, 1130, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1129, 0,
+	0, 0, 0, 0, 1128, 0, 1127, 1126, 0, 0, 0, 0, 0, 0, 1125, 0,
+	1124, 1123, 0, 1122, 1121, 1120, 0, 0, 0, 0, 0, 0, 0, 1119, 0, 1118,
+	1117, 0, 1116, 1115, 1114, 0, 1113, 1112, 1111, 1110, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1109, 0, 0, 0, 0, 0, 1108, 0, 1107, 1106, 0, 0, 0, 0,
+	0, 0, 1105, 0, 1104, 1103, 0, 1102, 1101, 1100, 0, 0, 0, 0, 0, 0,
+	0, 1099, 0, 1098, 1097, 0, 1096, 1095, 1094, 0, 1093, 1092, 1091, 1090, 0, 0,
+	0, 0, 0, 0, 0, 0, 1089, 0, 1088, 1087, 0, 1086, 1085, 1084, 0, 1083,
+	1082, 1081, 1080, 0, 1079, 1078, 1077, 1076, 1075, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1074, 0, 0, 0, 0, 0,
+	1073, 0, 1072, 1071, 0, 0, 0, 0, 0, 0, 1070, 0, 1069, 1068, 0, 1067,
+	1066, 1065, 0, 0, 0, 0, 0, 0, 0, 1064, 0, 1063, 1062, 0, 1061, 1060,
+	1059, 0, 1058, 1057, 1056, 1055, 0, 0, 0, 0, 0, 0, 0, 0, 1054, 0,
+	1053, 1052, 0, 1051, 1050, 1049, 0, 1048, 1047, 1046, 1045, 0, 1044, 1043, 1042, 1041,
+	1040, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1039, 0, 1038, 1037, 0, 1036,
+	1035, 1034, 0, 1033, 1032, 1031, 1030, 0, 1029, 1028, 1027, 1026, 1025, 0, 1024, 1023,
+	1022, 1021, 1020, 1019, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1018,
+	0, 0, 0, 0, 0, 1017, 0, 1016, 1015, 0, 0, 0, 0, 0, 0, 1014,
+	0, 1013, 1012, 0, 1011, 1010, 1009, 0, 0, 0, 0, 0, 0, 0, 1008, 0,
+	1007, 1006, 0, 1005, 1004, 1003, 0, 1002, 1001, 1000, 999, 0, 0, 0, 0, 0,
+	0, 0, 0, 998, 0, 997, 996, 0, 995, 994, 993, 0, 992, 991, 990, 989,
+	0, 988, 987, 986, 985, 984, 0, 0, 0, 0, 0, 0, 0, 0, 0, 983,
+	0, 982, 981, 0, 980, 979, 978, 0, 977, 976, 975, 974, 0, 973, 972, 971,
+	970, 969, 0, 968, 967, 966, 965, 964, 963, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 962, 0, 961, 960, 0, 959, 958, 957, 0, 956, 955, 954, 953,
+	0, 952, 951, 950, 949, 948, 0, 947, 946, 945, 944, 943, 942, 0, 941, 940,
+	939, 938, 937, 936, 935, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 934, 0, 0, 0, 0,
+	0, 933, 0, 932, 931, 0, 0, 0, 0, 0, 0, 930, 0, 929, 928, 0,
+	927, 926, 925, 0, 0, 0, 0, 0, 0, 0, 924, 0, 923, 922, 0, 921,
+	920, 919, 0, 918, 917, 916, 915, 0, 0, 0, 0, 0, 0, 0, 0, 914,
+	0, 913, 912, 0, 911, 910, 909, 0, 908, 907, 906, 905, 0, 904, 903, 902,
+	901, 900, 0, 0, 0, 0, 0, 0, 0, 0, 0, 899, 0, 898, 897, 0,
+	896, 895, 894, 0, 893, 892, 891, 890, 0, 889, 888, 887, 886, 885, 0, 884,
+	883, 882, 881, 880, 879, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 878,
+	0, 877, 876, 0, 875, 874, 873, 0, 872, 871, 870, 869, 0, 868, 867, 866,
+	865, 864, 0, 863, 862, 861, 860, 859, 858, 0, 857, 856, 855, 854, 853, 852,
+	851, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 850, 0, 849, 848,
+	0, 847, 846, 845, 0, 844, 843, 842, 841, 0, 840, 839, 838, 837, 836, 0,
+	835, 834, 833, 832, 831, 830, 0, 829, 828, 827, 826, 825, 824, 823, 0, 822,
+	821, 820, 819, 818, 817, 816, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 815, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 814, 0, 0, 0, 0, 0, 813, 0,
+	812, 811, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 810, 0, 0, 0, 0,
+	0, 809, 0, 808, 807, 0, 0, 0, 0, 0, 0, 806, 0, 805, 804, 0,
+	803, 802, 801, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 800, 0, 0, 0, 0, 0, 799, 0, 798, 797, 0, 0, 0,
+	0, 0, 0, 796, 0, 795, 794, 0, 793, 792, 791, 0, 0, 0, 0, 0,
+	0, 0, 790, 0, 789, 788, 0, 787, 786, 785, 0, 784, 783, 782, 781, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 780, 0, 0, 0, 0, 0, 779, 0, 778,
+	777, 0, 0, 0, 0, 0, 0, 776, 0, 775, 774, 0, 773, 772, 771, 0,
+	0, 0, 0, 0, 0, 0, 770, 0, 769, 768, 0, 767, 766, 765, 0, 764,
+	763, 762, 761, 0, 0, 0, 0, 0, 0, 0, 0, 760, 0, 759, 758, 0,
+	757, 756, 755, 0, 754, 753, 752, 751, 0, 750, 749, 748, 747, 746, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 745,
+	0, 0, 0, 0, 0, 744, 0, 743, 742, 0, 0, 0, 0, 0, 0, 741,
+	0, 740, 739, 0, 738, 737, 736, 0, 0, 0, 0, 0, 0, 0, 735, 0,
+	734, 733, 0, 732, 731, 730, 0, 729, 728, 727, 726, 0, 0, 0, 0, 0,
+	0, 0, 0, 725, 0, 724, 723, 0, 722, 721, 720, 0, 719, 718, 717, 716,
+	0, 715, 714, 713, 712, 711, 0, 0, 0, 0, 0, 0, 0, 0, 0, 710,
+	0, 709, 708, 0, 707, 706, 705, 0, 704, 703, 702, 701, 0, 700, 699, 698,
+	697, 696, 0, 695, 694, 693, 692, 691, 690, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 689, 0, 0, 0, 0, 0, 688, 0, 687, 686, 0, 0,
+	0, 0, 0, 0, 685, 0, 684, 683, 0, 682, 681, 680, 0, 0, 0, 0,
+	0, 0, 0, 679, 0, 678, 677, 0, 676, 675, 674, 0, 673, 672, 671, 670,
+	0, 0, 0, 0, 0, 0, 0, 0, 669, 0, 668, 667, 0, 666, 665, 664,
+	0, 663, 662, 661, 660, 0, 659, 658, 657, 656, 655, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 654, 0, 653, 652, 0, 651, 650, 649, 0, 648, 647, 646,
+	645, 0, 644, 643, 642, 641, 640, 0, 639, 638, 637, 636, 635, 634, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 633, 0, 632, 631, 0, 630, 629, 628,
+	0, 627, 626, 625, 624, 0, 623, 622, 621, 620, 619, 0, 618, 617, 616, 615,
+	614, 613, 0, 612, 611, 610, 609, 608, 607, 606, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	605, 0, 0, 0, 0, 0, 604, 0, 603, 602, 0, 0, 0, 0, 0, 0,
+	601, 0, 600, 599, 0, 598, 597, 596, 0, 0, 0, 0, 0, 0, 0, 595,
+	0, 594, 593, 0, 592, 591, 590, 0, 589, 588, 587, 586, 0, 0, 0, 0,
+	0, 0, 0, 0, 585, 0, 584, 583, 0, 582, 581, 580, 0, 579, 578, 577,
+	576, 0, 575, 574, 573, 572, 571, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	570, 0, 569, 568, 0, 567, 566, 565, 0, 564, 563, 562, 561, 0, 560, 559,
+	558, 557, 556, 0, 555, 554, 553, 552, 551, 550, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 549, 0, 548, 547, 0, 546, 545, 544, 0, 543, 542, 541,
+	540, 0, 539, 538, 537, 536, 535, 0, 534, 533, 532, 531, 530, 529, 0, 528,
+	527, 526, 525, 524, 523, 522, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 521, 0, 520, 519, 0, 518, 517, 516, 0, 515, 514, 513, 512, 0, 511,
+	510, 509, 508, 507, 0, 506, 505, 504, 503, 502, 501, 0, 500, 499, 498, 497,
+	496, 495, 494, 0, 493, 492, 491, 490, 489, 488, 487, 486, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 485, 0,
+	0, 0, 0, 0, 484, 0, 483, 482, 0, 0, 0, 0, 0, 0, 481, 0,
+	480, 479, 0, 478, 477, 476, 0, 0, 0, 0, 0, 0, 0, 475, 0, 474,
+	473, 0, 472, 471, 470, 0, 469, 468, 467, 466, 0, 0, 0, 0, 0, 0,
+	0, 0, 465, 0, 464, 463, 0, 462, 461, 460, 0, 459, 458, 457, 456, 0,
+	455, 454, 453, 452, 451, 0, 0, 0, 0, 0, 0, 0, 0, 0, 450, 0,
+	449, 448, 0, 447, 446, 445, 0, 444, 443, 442, 441, 0, 440, 439, 438, 437,
+	436, 0, 435, 434, 433, 432, 431, 430, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 429, 0, 428, 427, 0, 426, 425, 424, 0, 423, 422, 421, 420, 0,
+	419, 418, 417, 416, 415, 0, 414, 413, 412, 411, 410, 409, 0, 408, 407, 406,
+	405, 404, 403, 402, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 401,
+	0, 400, 399, 0, 398, 397, 396, 0, 395, 394, 393, 392, 0, 391, 390, 389,
+	388, 387, 0, 386, 385, 384, 383, 382, 381, 0, 380, 379, 378, 377, 376, 375,
+	374, 0, 373, 372, 371, 370, 369, 368, 367, 366, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 365, 0, 364, 363, 0, 362, 361, 360, 0, 359,
+	358, 357, 356, 0, 355, 354, 353, 352, 351, 0, 350, 349, 348, 347, 346, 345,
+	0, 344, 343, 342, 341, 340, 339, 338, 0, 337, 336, 335, 334, 333, 332, 331,
+	330, 0, 329, 328, 327, 326, 325, 324, 323, 322, 1, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+}
+
+// lowA5Table holds LookupA5Low classes of 5-card hands, by rankIndex
+var lowA5Table = [6188]uint16{
+	0, 143, 299, 287, 131, 0, 142, 1102, 2005, 1036, 130, 298, 1983, 1972, 286, 275,
+	970, 274, 119, 118, 0, 141, 1100, 2004, 1034, 129, 1099, 4665, 4445, 1033, 1982, 4225,
+	1971, 968, 967, 117, 297, 1950, 1939, 285, 1949, 4005, 1938, 1928, 1927, 273, 263, 904,
+	262, 902, 901, 261, 107, 106, 105, 0, 140, 1097, 2003, 1031, 128, 1096, 4662, 4442,
+	1030, 1981, 4222, 1970, 965, 964, 116, 1095, 4660, 4440, 1029, 4659, 6170, 4439, 4220, 4219,
+	963, 1948, 4002, 1937, 4000, 3999, 1926, 899, 898, 897, 104, 296, 1906, 1895, 284, 1905,
+	3785, 1894, 1884, 1883, 272, 1904, 3782, 1893, 3780, 3779, 1882, 1873, 1872, 1871, 260, 251,
+	838, 250, 836, 835, 249, 833, 832, 831, 248, 95, 94, 93, 92, 0, 139, 1093,
+	2002, 1027, 127, 1092, 4656, 4436, 1026, 1980, 4216, 1969, 961, 960, 115, 1091, 4654, 4434,
+	1025, 4653, 6165, 4433, 4214, 4213, 959, 1947, 3996, 1936, 3994, 3993, 1925, 895, 894, 893,
+	103, 1090, 4651, 4431, 1024, 4650, 6161, 4430, 4211, 4210, 958, 4649, 6158, 4429, 6156, 6155,
+	4209, 3991, 3990, 3989, 892, 1903, 3776, 1892, 3774, 3773, 1881, 3771, 3770, 3769, 1870, 829,
+	828, 827, 826, 91, 295, 1851, 1840, 283, 1850, 3565, 1839, 1829, 1828, 271, 1849, 3562,
+	1838, 3560, 3559, 1827, 1818, 1817, 1816, 259, 1848, 3556, 1837, 3554, 3553, 1826, 3551, 3550,
+	3549, 1815, 1807, 1806, 1805, 1804, 247, 239, 772, 238, 770, 769, 237, 767, 766, 765,
+	236, 763, 762, 761, 760, 235, 83, 82, 81, 80, 79, 0, 138, 1088, 2001, 1022,
+	126, 1087, 4646, 4426, 1021, 1979, 4206, 1968, 956, 955, 114, 1086, 4644, 4424, 1020, 4643,
+	6150, 4423, 4204, 4203, 954, 1946, 3986, 1935, 3984, 3983, 1924, 890, 889, 888, 102, 1085,
+	4641, 4421, 1019, 4640, 6146, 4420, 4201, 4200, 953, 4639, 6143, 4419, 6141, 6140, 4199, 3981,
+	3980, 3979, 887, 1902, 3766, 1891, 3764, 3763, 1880, 3761, 3760, 3759, 1869, 824, 823, 822,
+	821, 90, 1084, 4637, 4417, 1018, 4636, 6136, 4416, 4197, 4196, 952, 4635, 6133, 4415, 6131,
+	6130, 4195, 3977, 3976, 3975, 886, 4634, 6127, 4414, 6125, 6124, 4194, 6122, 6121, 6120, 3974,
+	3757, 3756, 3755, 3754, 820, 1847, 3546, 1836, 3544, 3543, 1825, 3541, 3540, 3539, 1814, 3537,
+	3536, 3535, 3534, 1803, 758, 757, 756, 755, 754, 78, 294, 1785, 1774, 282, 1784, 3345,
+	1773, 1763, 1762, 270, 1783, 3342, 1772, 3340, 3339, 1761, 1752, 1751, 1750, 258, 1782, 3336,
+	1771, 3334, 3333, 1760, 3331, 3330, 3329, 1749, 1741, 1740, 1739, 1738, 246, 1781, 3326, 1770,
+	3324, 3323, 1759, 3321, 3320, 3319, 1748, 3317, 3316, 3315, 3314, 1737, 1730, 1729, 1728, 1727,
+	1726, 234, 227, 706, 226, 704, 703, 225, 701, 700, 699, 224, 697, 696, 695, 694,
+	223, 692, 691, 690, 689, 688, 222, 71, 70, 69, 68, 67, 66, 0, 137, 1082,
+	2000, 1016, 125, 1081, 4631, 4411, 1015, 1978, 4191, 1967, 950, 949, 113, 1080, 4629, 4409,
+	1014, 4628, 6115, 4408, 4189, 4188, 948, 1945, 3971, 1934, 3969, 3968, 1923, 884, 883, 882,
+	101, 1079, 4626, 4406, 1013, 4625, 6111, 4405, 4186, 4185, 947, 4624, 6108, 4404, 6106, 6105,
+	4184, 3966, 3965, 3964, 881, 1901, 3751, 1890, 3749, 3748, 1879, 3746, 3745, 3744, 1868, 818,
+	817, 816, 815, 89, 1078, 4622, 4402, 1012, 4621, 6101, 4401, 4182, 4181, 946, 4620, 6098,
+	4400, 6096, 6095, 4180, 3962, 3961, 3960, 880, 4619, 6092, 4399, 6090, 6089, 4179, 6087, 6086,
+	6085, 3959, 3742, 3741, 3740, 3739, 814, 1846, 3531, 1835, 3529, 3528, 1824, 3526, 3525, 3524,
+	1813, 3522, 3521, 3520, 3519, 1802, 752, 751, 750, 749, 748, 77, 1077, 4617, 4397, 1011,
+	4616, 6081, 4396, 4177, 4176, 945, 4615, 6078, 4395, 6076, 6075, 4175, 3957, 3956, 3955, 879,
+	4614, 6072, 4394, 6070, 6069, 4174, 6067, 6066, 6065, 3954, 3737, 3736, 3735, 3734, 813, 4613,
+	6062, 4393, 6060, 6059, 4173, 6057, 6056, 6055, 3953, 6053, 6052, 6051, 6050, 3733, 3517, 3516,
+	3515, 3514, 3513, 747, 1780, 3311, 1769, 3309, 3308, 1758, 3306, 3305, 3304, 1747, 3302, 3301,
+	3300, 3299, 1736, 3297, 3296, 3295, 3294, 3293, 1725, 686, 685, 684, 683, 682, 681, 65,
+	293, 1708, 1697, 281, 1707, 3125, 1696, 1686, 1685, 269, 1706, 3122, 1695, 3120, 3119, 1684,
+	1675, 1674, 1673, 257, 1705, 3116, 1694, 3114, 3113, 1683, 3111, 3110, 3109, 1672, 1664, 1663,
+	1662, 1661, 245, 1704, 3106, 1693, 3104, 3103, 1682, 3101, 3100, 3099, 1671, 3097, 3096, 3095,
+	3094, 1660, 1653, 1652, 1651, 1650, 1649, 233, 1703, 3091, 1692, 3089, 3088, 1681, 3086, 3085,
+	3084, 1670, 3082, 3081, 3080, 3079, 1659, 3077, 3076, 3075, 3074, 3073, 1648, 1642, 1641, 1640,
+	1639, 1638, 1637, 221, 215, 640, 214, 638, 637, 213, 635, 634, 633, 212, 631, 630,
+	629, 628, 211, 626, 625, 624, 623, 622, 210, 620, 619, 618, 617, 616, 615, 209,
+	59, 58, 57, 56, 55, 54, 53, 0, 136, 1075, 1999, 1009, 124, 1074, 4610, 4390,
+	1008, 1977, 4170, 1966, 943, 942, 112, 1073, 4608, 4388, 1007, 4607, 6045, 4387, 4168, 4167,
+	941, 1944, 3950, 1933, 3948, 3947, 1922, 877, 876, 875, 100, 1072, 4605, 4385, 1006, 4604,
+	6041, 4384, 4165, 4164, 940, 4603, 6038, 4383, 6036, 6035, 4163, 3945, 3944, 3943, 874, 1900,
+	3730, 1889, 3728, 3727, 1878, 3725, 3724, 3723, 1867, 811, 810, 809, 808, 88, 1071, 4601,
+	4381, 1005, 4600, 6031, 4380, 4161, 4160, 939, 4599, 6028, 4379, 6026, 6025, 4159, 3941, 3940,
+	3939, 873, 4598, 6022, 4378, 6020, 6019, 4158, 6017, 6016, 6015, 3938, 3721, 3720, 3719, 3718,
+	807, 1845, 3510, 1834, 3508, 3507, 1823, 3505, 3504, 3503, 1812, 3501, 3500, 3499, 3498, 1801,
+	745, 744, 743, 742, 741, 76, 1070, 4596, 4376, 1004, 4595, 6011, 4375, 4156, 4155, 938,
+	4594, 6008, 4374, 6006, 6005, 4154, 3936, 3935, 3934, 872, 4593, 6002, 4373, 6000, 5999, 4153,
+	5997, 5996, 5995, 3933, 3716, 3715, 3714, 3713, 806, 4592, 5992, 4372, 5990, 5989, 4152, 5987,
+	5986, 5985, 3932, 5983, 5982, 5981, 5980, 3712, 3496, 3495, 3494, 3493, 3492, 740, 1779, 3290,
+	1768, 3288, 3287, 1757, 3285, 3284, 3283, 1746, 3281, 3280, 3279, 3278, 1735, 3276, 3275, 3274,
+	3273, 3272, 1724, 679, 678, 677, 676, 675, 674, 64, 1069, 4590, 4370, 1003, 4589, 5976,
+	4369, 4150, 4149, 937, 4588, 5973, 4368, 5971, 5970, 4148, 3930, 3929, 3928, 871, 4587, 5967,
+	4367, 5965, 5964, 4147, 5962, 5961, 5960, 3927, 3710, 3709, 3708, 3707, 805, 4586, 5957, 4366,
+	5955, 5954, 4146, 5952, 5951, 5950, 3926, 5948, 5947, 5946, 5945, 3706, 3490, 3489, 3488, 3487,
+	3486, 739, 4585, 5942, 4365, 5940, 5939, 4145, 5937, 5936, 5935, 3925, 5933, 5932, 5931, 5930,
+	3705, 5928, 5927, 5926, 5925, 5924, 3485, 3270, 3269, 3268, 3267, 3266, 3265, 673, 1702, 3070,
+	1691, 3068, 3067, 1680, 3065, 3064, 3063, 1669, 3061, 3060, 3059, 3058, 1658, 3056, 3055, 3054,
+	3053, 3052, 1647, 3050, 3049, 3048, 3047, 3046, 3045, 1636, 613, 612, 611, 610, 609, 608,
+	607, 52, 292, 1620, 1609, 280, 1619, 2905, 1608, 1598, 1597, 268, 1618, 2902, 1607, 2900,
+	2899, 1596, 1587, 1586, 1585, 256, 1617, 2896, 1606, 2894, 2893, 1595, 2891, 2890, 2889, 1584,
+	1576, 1575, 1574, 1573, 244, 1616, 2886, 1605, 2884, 2883, 1594, 2881, 2880, 2879, 1583, 2877,
+	2876, 2875, 2874, 1572, 1565, 1564, 1563, 1562, 1561, 232, 1615, 2871, 1604, 2869, 2868, 1593,
+	2866, 2865, 2864, 1582, 2862, 2861, 2860, 2859, 1571, 2857, 2856, 2855, 2854, 2853, 1560, 1554,
+	1553, 1552, 1551, 1550, 1549, 220, 1614, 2850, 1603, 2848, 2847, 1592, 2845, 2844, 2843, 1581,
+	2841, 2840, 2839, 2838, 1570, 2836, 2835, 2834, 2833, 2832, 1559, 2830, 2829, 2828, 2827, 2826,
+	2825, 1548, 1543, 1542, 1541, 1540, 1539, 1538, 1537, 208, 203, 574, 202, 572, 571, 201,
+	569, 568, 567, 200, 565, 564, 563, 562, 199, 560, 559, 558, 557, 556, 198, 554,
+	553, 552, 551, 550, 549, 197, 547, 546, 545, 544, 543, 542, 541, 196, 47, 46,
+	45, 44, 43, 42, 41, 40, 0, 135, 1067, 1998, 1001, 123, 1066, 4582, 4362, 1000,
+	1976, 4142, 1965, 935, 934, 111, 1065, 4580, 4360, 999, 4579, 5919, 4359, 4140, 4139, 933,
+	1943, 3922, 1932, 3920, 3919, 1921, 869, 868, 867, 99, 1064, 4577, 4357, 998, 4576, 5915,
+	4356, 4137, 4136, 932, 4575, 5912, 4355, 5910, 5909, 4135, 3917, 3916, 3915, 866, 1899, 3702,
+	1888, 3700, 3699, 1877, 3697, 3696, 3695, 1866, 803, 802, 801, 800, 87, 1063, 4573, 4353,
+	997, 4572, 5905, 4352, 4133, 4132, 931, 4571, 5902, 4351, 5900, 5899, 4131, 3913, 3912, 3911,
+	865, 4570, 5896, 4350, 5894, 5893, 4130, 5891, 5890, 5889, 3910, 3693, 3692, 3691, 3690, 799,
+	1844, 3482, 1833, 3480, 3479, 1822, 3477, 3476, 3475, 1811, 3473, 3472, 3471, 3470, 1800, 737,
+	736, 735, 734, 733, 75, 1062, 4568, 4348, 996, 4567, 5885, 4347, 4128, 4127, 930, 4566,
+	5882, 4346, 5880, 5879, 4126, 3908, 3907, 3906, 864, 4565, 5876, 4345, 5874, 5873, 4125, 5871,
+	5870, 5869, 3905, 3688, 3687, 3686, 3685, 798, 4564, 5866, 4344, 5864, 5863, 4124, 5861, 5860,
+	5859, 3904, 5857, 5856, 5855, 5854, 3684, 3468, 3467, 3466, 3465, 3464, 732, 1778, 3262, 1767,
+	3260, 3259, 1756, 3257, 3256, 3255, 1745, 3253, 3252, 3251, 3250, 1734, 3248, 3247, 3246, 3245,
+	3244, 1723, 671, 670, 669, 668, 667, 666, 63, 1061, 4562, 4342, 995, 4561, 5850, 4341,
+	4122, 4121, 929, 4560, 5847, 4340, 5845, 5844, 4120, 3902, 3901, 3900, 863, 4559, 5841, 4339,
+	5839, 5838, 4119, 5836, 5835, 5834, 3899, 3682, 3681, 3680, 3679, 797, 4558, 5831, 4338, 5829,
+	5828, 4118, 5826, 5825, 5824, 3898, 5822, 5821, 5820, 5819, 3678, 3462, 3461, 3460, 3459, 3458,
+	731, 4557, 5816, 4337, 5814, 5813, 4117, 5811, 5810, 5809, 3897, 5807, 5806, 5805, 5804, 3677,
+	5802, 5801, 5800, 5799, 5798, 3457, 3242, 3241, 3240, 3239, 3238, 3237, 665, 1701, 3042, 1690,
+	3040, 3039, 1679, 3037, 3036, 3035, 1668, 3033, 3032, 3031, 3030, 1657, 3028, 3027, 3026, 3025,
+	3024, 1646, 3022, 3021, 3020, 3019, 3018, 3017, 1635, 605, 604, 603, 602, 601, 600, 599,
+	51, 1060, 4555, 4335, 994, 4554, 5794, 4334, 4115, 4114, 928, 4553, 5791, 4333, 5789, 5788,
+	4113, 3895, 3894, 3893, 862, 4552, 5785, 4332, 5783, 5782, 4112, 5780, 5779, 5778, 3892, 3675,
+	3674, 3673, 3672, 796, 4551, 5775, 4331, 5773, 5772, 4111, 5770, 5769, 5768, 3891, 5766, 5765,
+	5764, 5763, 3671, 3455, 3454, 3453, 3452, 3451, 730, 4550, 5760, 4330, 5758, 5757, 4110, 5755,
+	5754, 5753, 3890, 5751, 5750, 5749, 5748, 3670, 5746, 5745, 5744, 5743, 5742, 3450, 3235, 3234,
+	3233, 3232, 3231, 3230, 664, 4549, 5739, 4329, 5737, 5736, 4109, 5734, 5733, 5732, 3889, 5730,
+	5729, 5728, 5727, 3669, 5725, 5724, 5723, 5722, 5721, 3449, 5719, 5718, 5717, 5716, 5715, 5714,
+	3229, 3015, 3014, 3013, 3012, 3011, 3010, 3009, 598, 1613, 2822, 1602, 2820, 2819, 1591, 2817,
+	2816, 2815, 1580, 2813, 2812, 2811, 2810, 1569, 2808, 2807, 2806, 2805, 2804, 1558, 2802, 2801,
+	2800, 2799, 2798, 2797, 1547, 2795, 2794, 2793, 2792, 2791, 2790, 2789, 1536, 539, 538, 537,
+	536, 535, 534, 533, 532, 39, 291, 1521, 1510, 279, 1520, 2685, 1509, 1499, 1498, 267,
+	1519, 2682, 1508, 2680, 2679, 1497, 1488, 1487, 1486, 255, 1518, 2676, 1507, 2674, 2673, 1496,
+	2671, 2670, 2669, 1485, 1477, 1476, 1475, 1474, 243, 1517, 2666, 1506, 2664, 2663, 1495, 2661,
+	2660, 2659, 1484, 2657, 2656, 2655, 2654, 1473, 1466, 1465, 1464, 1463, 1462, 231, 1516, 2651,
+	1505, 2649, 2648, 1494, 2646, 2645, 2644, 1483, 2642, 2641, 2640, 2639, 1472, 2637, 2636, 2635,
+	2634, 2633, 1461, 1455, 1454, 1453, 1452, 1451, 1450, 219, 1515, 2630, 1504, 2628, 2627, 1493,
+	2625, 2624, 2623, 1482, 2621, 2620, 2619, 2618, 1471, 2616, 2615, 2614, 2613, 2612, 1460, 2610,
+	2609, 2608, 2607, 2606, 2605, 1449, 1444, 1443, 1442, 1441, 1440, 1439, 1438, 207, 1514, 2602,
+	1503, 2600, 2599, 1492, 2597, 2596, 2595, 1481, 2593, 2592, 2591, 2590, 1470, 2588, 2587, 2586,
+	2585, 2584, 1459, 2582, 2581, 2580, 2579, 2578, 2577, 1448, 2575, 2574, 2573, 2572, 2571, 2570,
+	2569, 1437, 1433, 1432, 1431, 1430, 1429, 1428, 1427, 1426, 195, 191, 508, 190, 506, 505,
+	189, 503, 502, 501, 188, 499, 498, 497, 496, 187, 494, 493, 492, 491, 490, 186,
+	488, 487, 486, 485, 484, 483, 185, 481, 480, 479, 478, 477, 476, 475, 184, 473,
+	472, 471, 470, 469, 468, 467, 466, 183, 35, 34, 33, 32, 31, 30, 29, 28,
+	27, 0, 134, 1058, 1997, 992, 122, 1057, 4546, 4326, 991, 1975, 4106, 1964, 926, 925,
+	110, 1056, 4544, 4324, 990, 4543, 5709, 4323, 4104, 4103, 924, 1942, 3886, 1931, 3884, 3883,
+	1920, 860, 859, 858, 98, 1055, 4541, 4321, 989, 4540, 5705, 4320, 4101, 4100, 923, 4539,
+	5702, 4319, 5700, 5699, 4099, 3881, 3880, 3879, 857, 1898, 3666, 1887, 3664, 3663, 1876, 3661,
+	3660, 3659, 1865, 794, 793, 792, 791, 86, 1054, 4537, 4317, 988, 4536, 5695, 4316, 4097,
+	4096, 922, 4535, 5692, 4315, 5690, 5689, 4095, 3877, 3876, 3875, 856, 4534, 5686, 4314, 5684,
+	5683, 4094, 5681, 5680, 5679, 3874, 3657, 3656, 3655, 3654, 790, 1843, 3446, 1832, 3444, 3443,
+	1821, 3441, 3440, 3439, 1810, 3437, 3436, 3435, 3434, 1799, 728, 727, 726, 725, 724, 74,
+	1053, 4532, 4312, 987, 4531, 5675, 4311, 4092, 4091, 921, 4530, 5672, 4310, 5670, 5669, 4090,
+	3872, 3871, 3870, 855, 4529, 5666, 4309, 5664, 5663, 4089, 5661, 5660, 5659, 3869, 3652, 3651,
+	3650, 3649, 789, 4528, 5656, 4308, 5654, 5653, 4088, 5651, 5650, 5649, 3868, 5647, 5646, 5645,
+	5644, 3648, 3432, 3431, 3430, 3429, 3428, 723, 1777, 3226, 1766, 3224, 3223, 1755, 3221, 3220,
+	3219, 1744, 3217, 3216, 3215, 3214, 1733, 3212, 3211, 3210, 3209, 3208, 1722, 662, 661, 660,
+	659, 658, 657, 62, 1052, 4526, 4306, 986, 4525, 5640, 4305, 4086, 4085, 920, 4524, 5637,
+	4304, 5635, 5634, 4084, 3866, 3865, 3864, 854, 4523, 5631, 4303, 5629, 5628, 4083, 5626, 5625,
+	5624, 3863, 3646, 3645, 3644, 3643, 788, 4522, 5621, 4302, 5619, 5618, 4082, 5616, 5615, 5614,
+	3862, 5612, 5611, 5610, 5609, 3642, 3426, 3425, 3424, 3423, 3422, 722, 4521, 5606, 4301, 5604,
+	5603, 4081, 5601, 5600, 5599, 3861, 5597, 5596, 5595, 5594, 3641, 5592, 5591, 5590, 5589, 5588,
+	3421, 3206, 3205, 3204, 3203, 3202, 3201, 656, 1700, 3006, 1689, 3004, 3003, 1678, 3001, 3000,
+	2999, 1667, 2997, 2996, 2995, 2994, 1656, 2992, 2991, 2990, 2989, 2988, 1645, 2986, 2985, 2984,
+	2983, 2982, 2981, 1634, 596, 595, 594, 593, 592, 591, 590, 50, 1051, 4519, 4299, 985,
+	4518, 5584, 4298, 4079, 4078, 919, 4517, 5581, 4297, 5579, 5578, 4077, 3859, 3858, 3857, 853,
+	4516, 5575, 4296, 5573, 5572, 4076, 5570, 5569, 5568, 3856, 3639, 3638, 3637, 3636, 787, 4515,
+	5565, 4295, 5563, 5562, 4075, 5560, 5559, 5558, 3855, 5556, 5555, 5554, 5553, 3635, 3419, 3418,
+	3417, 3416, 3415, 721, 4514, 5550, 4294, 5548, 5547, 4074, 5545, 5544, 5543, 3854, 5541, 5540,
+	5539, 5538, 3634, 5536, 5535, 5534, 5533, 5532, 3414, 3199, 3198, 3197, 3196, 3195, 3194, 655,
+	4513, 5529, 4293, 5527, 5526, 4073, 5524, 5523, 5522, 3853, 5520, 5519, 5518, 5517, 3633, 5515,
+	5514, 5513, 5512, 5511, 3413, 5509, 5508, 5507, 5506, 5505, 5504, 3193, 2979, 2978, 2977, 2976,
+	2975, 2974, 2973, 589, 1612, 2786, 1601, 2784, 2783, 1590, 2781, 2780, 2779, 1579, 2777, 2776,
+	2775, 2774, 1568, 2772, 2771, 2770, 2769, 2768, 1557, 2766, 2765, 2764, 2763, 2762, 2761, 1546,
+	2759, 2758, 2757, 2756, 2755, 2754, 2753, 1535, 530, 529, 528, 527, 526, 525, 524, 523,
+	38, 1050, 4511, 4291, 984, 4510, 5500, 4290, 4071, 4070, 918, 4509, 5497, 4289, 5495, 5494,
+	4069, 3851, 3850, 3849, 852, 4508, 5491, 4288, 5489, 5488, 4068, 5486, 5485, 5484, 3848, 3631,
+	3630, 3629, 3628, 786, 4507, 5481, 4287, 5479, 5478, 4067, 5476, 5475, 5474, 3847, 5472, 5471,
+	5470, 5469, 3627, 3411, 3410, 3409, 3408, 3407, 720, 4506, 5466, 4286, 5464, 5463, 4066, 5461,
+	5460, 5459, 3846, 5457, 5456, 5455, 5454, 3626, 5452, 5451, 5450, 5449, 5448, 3406, 3191, 3190,
+	3189, 3188, 3187, 3186, 654, 4505, 5445, 4285, 5443, 5442, 4065, 5440, 5439, 5438, 3845, 5436,
+	5435, 5434, 5433, 3625, 5431, 5430, 5429, 5428, 5427, 3405, 5425, 5424, 5423, 5422, 5421, 5420,
+	3185, 2971, 2970, 2969, 2968, 2967, 2966, 2965, 588, 4504, 5417, 4284, 5415, 5414, 4064, 5412,
+	5411, 5410, 3844, 5408, 5407, 5406, 5405, 3624, 5403, 5402, 5401, 5400, 5399, 3404, 5397, 5396,
+	5395, 5394, 5393, 5392, 3184, 5390, 5389, 5388, 5387, 5386, 5385, 5384, 2964, 2751, 2750, 2749,
+	2748, 2747, 2746, 2745, 2744, 522, 1513, 2566, 1502, 2564, 2563, 1491, 2561, 2560, 2559, 1480,
+	2557, 2556, 2555, 2554, 1469, 2552, 2551, 2550, 2549, 2548, 1458, 2546, 2545, 2544, 2543, 2542,
+	2541, 1447, 2539, 2538, 2537, 2536, 2535, 2534, 2533, 1436, 2531, 2530, 2529, 2528, 2527, 2526,
+	2525, 2524, 1425, 464, 463, 462, 461, 460, 459, 458, 457, 456, 26, 290, 1411, 1400,
+	278, 1410, 2465, 1399, 1389, 1388, 266, 1409, 2462, 1398, 2460, 2459, 1387, 1378, 1377, 1376,
+	254, 1408, 2456, 1397, 2454, 2453, 1386, 2451, 2450, 2449, 1375, 1367, 1366, 1365, 1364, 242,
+	1407, 2446, 1396, 2444, 2443, 1385, 2441, 2440, 2439, 1374, 2437, 2436, 2435, 2434, 1363, 1356,
+	1355, 1354, 1353, 1352, 230, 1406, 2431, 1395, 2429, 2428, 1384, 2426, 2425, 2424, 1373, 2422,
+	2421, 2420, 2419, 1362, 2417, 2416, 2415, 2414, 2413, 1351, 1345, 1344, 1343, 1342, 1341, 1340,
+	218, 1405, 2410, 1394, 2408, 2407, 1383, 2405, 2404, 2403, 1372, 2401, 2400, 2399, 2398, 1361,
+	2396, 2395, 2394, 2393, 2392, 1350, 2390, 2389, 2388, 2387, 2386, 2385, 1339, 1334, 1333, 1332,
+	1331, 1330, 1329, 1328, 206, 1404, 2382, 1393, 2380, 2379, 1382, 2377, 2376, 2375, 1371, 2373,
+	2372, 2371, 2370, 1360, 2368, 2367, 2366, 2365, 2364, 1349, 2362, 2361, 2360, 2359, 2358, 2357,
+	1338, 2355, 2354, 2353, 2352, 2351, 2350, 2349, 1327, 1323, 1322, 1321, 1320, 1319, 1318, 1317,
+	1316, 194, 1403, 2346, 1392, 2344, 2343, 1381, 2341, 2340, 2339, 1370, 2337, 2336, 2335, 2334,
+	1359, 2332, 2331, 2330, 2329, 2328, 1348, 2326, 2325, 2324, 2323, 2322, 2321, 1337, 2319, 2318,
+	2317, 2316, 2315, 2314, 2313, 1326, 2311, 2310, 2309, 2308, 2307, 2306, 2305, 2304, 1315, 1312,
+	1311, 1310, 1309, 1308, 1307, 1306, 1305, 1304, 182, 179, 442, 178, 440, 439, 177, 437,
+	436, 435, 176, 433, 432, 431, 430, 175, 428, 427, 426, 425, 424, 174, 422, 421,
+	420, 419, 418, 417, 173, 415, 414, 413, 412, 411, 410, 409, 172, 407, 406, 405,
+	404, 403, 402, 401, 400, 171, 398, 397, 396, 395, 394, 393, 392, 391, 390, 170,
+	23, 22, 21, 20, 19, 18, 17, 16, 15, 14, 0, 133, 1048, 1996, 982, 121,
+	1047, 4501, 4281, 981, 1974, 4061, 1963, 916, 915, 109, 1046, 4499, 4279, 980, 4498, 5379,
+	4278, 4059, 4058, 914, 1941, 3841, 1930, 3839, 3838, 1919, 850, 849, 848, 97, 1045, 4496,
+	4276, 979, 4495, 5375, 4275, 4056, 4055, 913, 4494, 5372, 4274, 5370, 5369, 4054, 3836, 3835,
+	3834, 847, 1897, 3621, 1886, 3619, 3618, 1875, 3616, 3615, 3614, 1864, 784, 783, 782, 781,
+	85, 1044, 4492, 4272, 978, 4491, 5365, 4271, 4052, 4051, 912, 4490, 5362, 4270, 5360, 5359,
+	4050, 3832, 3831, 3830, 846, 4489, 5356, 4269, 5354, 5353, 4049, 5351, 5350, 5349, 3829, 3612,
+	3611, 3610, 3609, 780, 1842, 3401, 1831, 3399, 3398, 1820, 3396, 3395, 3394, 1809, 3392, 3391,
+	3390, 3389, 1798, 718, 717, 716, 715, 714, 73, 1043, 4487, 4267, 977, 4486, 5345, 4266,
+	4047, 4046, 911, 4485, 5342, 4265, 5340, 5339, 4045, 3827, 3826, 3825, 845, 4484, 5336, 4264,
+	5334, 5333, 4044, 5331, 5330, 5329, 3824, 3607, 3606, 3605, 3604, 779, 4483, 5326, 4263, 5324,
+	5323, 4043, 5321, 5320, 5319, 3823, 5317, 5316, 5315, 5314, 3603, 3387, 3386, 3385, 3384, 3383,
+	713, 1776, 3181, 1765, 3179, 3178, 1754, 3176, 3175, 3174, 1743, 3172, 3171, 3170, 3169, 1732,
+	3167, 3166, 3165, 3164, 3163, 1721, 652, 651, 650, 649, 648, 647, 61, 1042, 4481, 4261,
+	976, 4480, 5310, 4260, 4041, 4040, 910, 4479, 5307, 4259, 5305, 5304, 4039, 3821, 3820, 3819,
+	844, 4478, 5301, 4258, 5299, 5298, 4038, 5296, 5295, 5294, 3818, 3601, 3600, 3599, 3598, 778,
+	4477, 5291, 4257, 5289, 5288, 4037, 5286, 5285, 5284, 3817, 5282, 5281, 5280, 5279, 3597, 3381,
+	3380, 3379, 3378, 3377, 712, 4476, 5276, 4256, 5274, 5273, 4036, 5271, 5270, 5269, 3816, 5267,
+	5266, 5265, 5264, 3596, 5262, 5261, 5260, 5259, 5258, 3376, 3161, 3160, 3159, 3158, 3157, 3156,
+	646, 1699, 2961, 1688, 2959, 2958, 1677, 2956, 2955, 2954, 1666, 2952, 2951, 2950, 2949, 1655,
+	2947, 2946, 2945, 2944, 2943, 1644, 2941, 2940, 2939, 2938, 2937, 2936, 1633, 586, 585, 584,
+	583, 582, 581, 580, 49, 1041, 4474, 4254, 975, 4473, 5254, 4253, 4034, 4033, 909, 4472,
+	5251, 4252, 5249, 5248, 4032, 3814, 3813, 3812, 843, 4471, 5245, 4251, 5243, 5242, 4031, 5240,
+	5239, 5238, 3811, 3594, 3593, 3592, 3591, 777, 4470, 5235, 4250, 5233, 5232, 4030, 5230, 5229,
+	5228, 3810, 5226, 5225, 5224, 5223, 3590, 3374, 3373, 3372, 3371, 3370, 711, 4469, 5220, 4249,
+	5218, 5217, 4029, 5215, 5214, 5213, 3809, 5211, 5210, 5209, 5208, 3589, 5206, 5205, 5204, 5203,
+	5202, 3369, 3154, 3153, 3152, 3151, 3150, 3149, 645, 4468, 5199, 4248, 5197, 5196, 4028, 5194,
+	5193, 5192, 3808, 5190, 5189, 5188, 5187, 3588, 5185, 5184, 5183, 5182, 5181, 3368, 5179, 5178,
+	5177, 5176, 5175, 5174, 3148, 2934, 2933, 2932, 2931, 2930, 2929, 2928, 579, 1611, 2741, 1600,
+	2739, 2738, 1589, 2736, 2735, 2734, 1578, 2732, 2731, 2730, 2729, 1567, 2727, 2726, 2725, 2724,
+	2723, 1556, 2721, 2720, 2719, 2718, 2717, 2716, 1545, 2714, 2713, 2712, 2711, 2710, 2709, 2708,
+	1534, 520, 519, 518, 517, 516, 515, 514, 513, 37, 1040, 4466, 4246, 974, 4465, 5170,
+	4245, 4026, 4025, 908, 4464, 5167, 4244, 5165, 5164, 4024, 3806, 3805, 3804, 842, 4463, 5161,
+	4243, 5159, 5158, 4023, 5156, 5155, 5154, 3803, 3586, 3585, 3584, 3583, 776, 4462, 5151, 4242,
+	5149, 5148, 4022, 5146, 5145, 5144, 3802, 5142, 5141, 5140, 5139, 3582, 3366, 3365, 3364, 3363,
+	3362, 710, 4461, 5136, 4241, 5134, 5133, 4021, 5131, 5130, 5129, 3801, 5127, 5126, 5125, 5124,
+	3581, 5122, 5121, 5120, 5119, 5118, 3361, 3146, 3145, 3144, 3143, 3142, 3141, 644, 4460, 5115,
+	4240, 5113, 5112, 4020, 5110, 5109, 5108, 3800, 5106, 5105, 5104, 5103, 3580, 5101, 5100, 5099,
+	5098, 5097, 3360, 5095, 5094, 5093, 5092, 5091, 5090, 3140, 2926, 2925, 2924, 2923, 2922, 2921,
+	2920, 578, 4459, 5087, 4239, 5085, 5084, 4019, 5082, 5081, 5080, 3799, 5078, 5077, 5076, 5075,
+	3579, 5073, 5072, 5071, 5070, 5069, 3359, 5067, 5066, 5065, 5064, 5063, 5062, 3139, 5060, 5059,
+	5058, 5057, 5056, 5055, 5054, 2919, 2706, 2705, 2704, 2703, 2702, 2701, 2700, 2699, 512, 1512,
+	2521, 1501, 2519, 2518, 1490, 2516, 2515, 2514, 1479, 2512, 2511, 2510, 2509, 1468, 2507, 2506,
+	2505, 2504, 2503, 1457, 2501, 2500, 2499, 2498, 2497, 2496, 1446, 2494, 2493, 2492, 2491, 2490,
+	2489, 2488, 1435, 2486, 2485, 2484, 2483, 2482, 2481, 2480, 2479, 1424, 454, 453, 452, 451,
+	450, 449, 448, 447, 446, 25, 1039, 4457, 4237, 973, 4456, 5050, 4236, 4017, 4016, 907,
+	4455, 5047, 4235, 5045, 5044, 4015, 3797, 3796, 3795, 841, 4454, 5041, 4234, 5039, 5038, 4014,
+	5036, 5035, 5034, 3794, 3577, 3576, 3575, 3574, 775, 4453, 5031, 4233, 5029, 5028, 4013, 5026,
+	5025, 5024, 3793, 5022, 5021, 5020, 5019, 3573, 3357, 3356, 3355, 3354, 3353, 709, 4452, 5016,
+	4232, 5014, 5013, 4012, 5011, 5010, 5009, 3792, 5007, 5006, 5005, 5004, 3572, 5002, 5001, 5000,
+	4999, 4998, 3352, 3137, 3136, 3135, 3134, 3133, 3132, 643, 4451, 4995, 4231, 4993, 4992, 4011,
+	4990, 4989, 4988, 3791, 4986, 4985, 4984, 4983, 3571, 4981, 4980, 4979, 4978, 4977, 3351, 4975,
+	4974, 4973, 4972, 4971, 4970, 3131, 2917, 2916, 2915, 2914, 2913, 2912, 2911, 577, 4450, 4967,
+	4230, 4965, 4964, 4010, 4962, 4961, 4960, 3790, 4958, 4957, 4956, 4955, 3570, 4953, 4952, 4951,
+	4950, 4949, 3350, 4947, 4946, 4945, 4944, 4943, 4942, 3130, 4940, 4939, 4938, 4937, 4936, 4935,
+	4934, 2910, 2697, 2696, 2695, 2694, 2693, 2692, 2691, 2690, 511, 4449, 4931, 4229, 4929, 4928,
+	4009, 4926, 4925, 4924, 3789, 4922, 4921, 4920, 4919, 3569, 4917, 4916, 4915, 4914, 4913, 3349,
+	4911, 4910, 4909, 4908, 4907, 4906, 3129, 4904, 4903, 4902, 4901, 4900, 4899, 4898, 2909, 4896,
+	4895, 4894, 4893, 4892, 4891, 4890, 4889, 2689, 2477, 2476, 2475, 2474, 2473, 2472, 2471, 2470,
+	2469, 445, 1402, 2301, 1391, 2299, 2298, 1380, 2296, 2295, 2294, 1369, 2292, 2291, 2290, 2289,
+	1358, 2287, 2286, 2285, 2284, 2283, 1347, 2281, 2280, 2279, 2278, 2277, 2276, 1336, 2274, 2273,
+	2272, 2271, 2270, 2269, 2268, 1325, 2266, 2265, 2264, 2263, 2262, 2261, 2260, 2259, 1314, 2257,
+	2256, 2255, 2254, 2253, 2252, 2251, 2250, 2249, 1303, 388, 387, 386, 385, 384, 383, 382,
+	381, 380, 379, 13, 289, 1290, 1279, 277, 1289, 2245, 1278, 1268, 1267, 265, 1288, 2242,
+	1277, 2240, 2239, 1266, 1257, 1256, 1255, 253, 1287, 2236, 1276, 2234, 2233, 1265, 2231, 2230,
+	2229, 1254, 1246, 1245, 1244, 1243, 241, 1286, 2226, 1275, 2224, 2223, 1264, 2221, 2220, 2219,
+	1253, 2217, 2216, 2215, 2214, 1242, 1235, 1234, 1233, 1232, 1231, 229, 1285, 2211, 1274, 2209,
+	2208, 1263, 2206, 2205, 2204, 1252, 2202, 2201, 2200, 2199, 1241, 2197, 2196, 2195, 2194, 2193,
+	1230, 1224, 1223, 1222, 1221, 1220, 1219, 217, 1284, 2190, 1273, 2188, 2187, 1262, 2185, 2184,
+	2183, 1251, 2181, 2180, 2179, 2178, 1240, 2176, 2175, 2174, 2173, 2172, 1229, 2170, 2169, 2168,
+	2167, 2166, 2165, 1218, 1213, 1212, 1211, 1210, 1209, 1208, 1207, 205, 1283, 2162, 1272, 2160,
+	2159, 1261, 2157, 2156, 2155, 1250, 2153, 2152, 2151, 2150, 1239, 2148, 2147, 2146, 2145, 2144,
+	1228, 2142, 2141, 2140, 2139, 2138, 2137, 1217, 2135, 2134, 2133, 2132, 2131, 2130, 2129, 1206,
+	1202, 1201, 1200, 1199, 1198, 1197, 1196, 1195, 193, 1282, 2126, 1271, 2124, 2123, 1260, 2121,
+	2120, 2119, 1249, 2117, 2116, 2115, 2114, 1238, 2112, 2111, 2110, 2109, 2108, 1227, 2106, 2105,
+	2104, 2103, 2102, 2101, 1216, 2099, 2098, 2097, 2096, 2095, 2094, 2093, 1205, 2091, 2090, 2089,
+	2088, 2087, 2086, 2085, 2084, 1194, 1191, 1190, 1189, 1188, 1187, 1186, 1185, 1184, 1183, 181,
+	1281, 2081, 1270, 2079, 2078, 1259, 2076, 2075, 2074, 1248, 2072, 2071, 2070, 2069, 1237, 2067,
+	2066, 2065, 2064, 2063, 1226, 2061, 2060, 2059, 2058, 2057, 2056, 1215, 2054, 2053, 2052, 2051,
+	2050, 2049, 2048, 1204, 2046, 2045, 2044, 2043, 2042, 2041, 2040, 2039, 1193, 2037, 2036, 2035,
+	2034, 2033, 2032, 2031, 2030, 2029, 1182, 1180, 1179, 1178, 1177, 1176, 1175, 1174, 1173, 1172,
+	1171, 169, 167, 376, 166, 374, 373, 165, 371, 370, 369, 164, 367, 366, 365, 364,
+	163, 362, 361, 360, 359, 358, 162, 356, 355, 354, 353, 352, 351, 161, 349, 348,
+	347, 346, 345, 344, 343, 160, 341, 340, 339, 338, 337, 336, 335, 334, 159, 332,
+	331, 330, 329, 328, 327, 326, 325, 324, 158, 322, 321, 320, 319, 318, 317, 316,
+	315, 314, 313, 157, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1, 0,
+	144, 1104, 2006, 1038, 132, 1103, 4668, 4448, 1037, 1984, 4228, 1973, 972, 971, 120, 1101,
+	4667, 4447, 1035, 4666, 6175, 4446, 4227, 4226, 969, 1951, 4008, 1940, 4007, 4006, 1929, 906,
+	905, 903, 108, 1098, 4664, 4444, 1032, 4663, 6174, 4443, 4224, 4223, 966, 4661, 6173, 4441,
+	6172, 6171, 4221, 4004, 4003, 4001, 900, 1907, 3788, 1896, 3787, 3786, 1885, 3784, 3783, 3781,
+	1874, 840, 839, 837, 834, 96, 1094, 4658, 4438, 1028, 4657, 6169, 4437, 4218, 4217, 962,
+	4655, 6168, 4435, 6167, 6166, 4215, 3998, 3997, 3995, 896, 4652, 6164, 4432, 6163, 6162, 4212,
+	6160, 6159, 6157, 3992, 3778, 3777, 3775, 3772, 830, 1852, 3568, 1841, 3567, 3566, 1830, 3564,
+	3563, 3561, 1819, 3558, 3557, 3555, 3552, 1808, 774, 773, 771, 768, 764, 84, 1089, 4648,
+	4428, 1023, 4647, 6154, 4427, 4208, 4207, 957, 4645, 6153, 4425, 6152, 6151, 4205, 3988, 3987,
+	3985, 891, 4642, 6149, 4422, 6148, 6147, 4202, 6145, 6144, 6142, 3982, 3768, 3767, 3765, 3762,
+	825, 4638, 6139, 4418, 6138, 6137, 4198, 6135, 6134, 6132, 3978, 6129, 6128, 6126, 6123, 3758,
+	3548, 3547, 3545, 3542, 3538, 759, 1786, 3348, 1775, 3347, 3346, 1764, 3344, 3343, 3341, 1753,
+	3338, 3337, 3335, 3332, 1742, 3328, 3327, 3325, 3322, 3318, 1731, 708, 707, 705, 702, 698,
+	693, 72, 1083, 4633, 4413, 1017, 4632, 6119, 4412, 4193, 4192, 951, 4630, 6118, 4410, 6117,
+	6116, 4190, 3973, 3972, 3970, 885, 4627, 6114, 4407, 6113, 6112, 4187, 6110, 6109, 6107, 3967,
+	3753, 3752, 3750, 3747, 819, 4623, 6104, 4403, 6103, 6102, 4183, 6100, 6099, 6097, 3963, 6094,
+	6093, 6091, 6088, 3743, 3533, 3532, 3530, 3527, 3523, 753, 4618, 6084, 4398, 6083, 6082, 4178,
+	6080, 6079, 6077, 3958, 6074, 6073, 6071, 6068, 3738, 6064, 6063, 6061, 6058, 6054, 3518, 3313,
+	3312, 3310, 3307, 3303, 3298, 687, 1709, 3128, 1698, 3127, 3126, 1687, 3124, 3123, 3121, 1676,
+	3118, 3117, 3115, 3112, 1665, 3108, 3107, 3105, 3102, 3098, 1654, 3093, 3092, 3090, 3087, 3083,
+	3078, 1643, 642, 641, 639, 636, 632, 627, 621, 60, 1076, 4612, 4392, 1010, 4611, 6049,
+	4391, 4172, 4171, 944, 4609, 6048, 4389, 6047, 6046, 4169, 3952, 3951, 3949, 878, 4606, 6044,
+	4386, 6043, 6042, 4166, 6040, 6039, 6037, 3946, 3732, 3731, 3729, 3726, 812, 4602, 6034, 4382,
+	6033, 6032, 4162, 6030, 6029, 6027, 3942, 6024, 6023, 6021, 6018, 3722, 3512, 3511, 3509, 3506,
+	3502, 746, 4597, 6014, 4377, 6013, 6012, 4157, 6010, 6009, 6007, 3937, 6004, 6003, 6001, 5998,
+	3717, 5994, 5993, 5991, 5988, 5984, 3497, 3292, 3291, 3289, 3286, 3282, 3277, 680, 4591, 5979,
+	4371, 5978, 5977, 4151, 5975, 5974, 5972, 3931, 5969, 5968, 5966, 5963, 3711, 5959, 5958, 5956,
+	5953, 5949, 3491, 5944, 5943, 5941, 5938, 5934, 5929, 3271, 3072, 3071, 3069, 3066, 3062, 3057,
+	3051, 614, 1621, 2908, 1610, 2907, 2906, 1599, 2904, 2903, 2901, 1588, 2898, 2897, 2895, 2892,
+	1577, 2888, 2887, 2885, 2882, 2878, 1566, 2873, 2872, 2870, 2867, 2863, 2858, 1555, 2852, 2851,
+	2849, 2846, 2842, 2837, 2831, 1544, 576, 575, 573, 570, 566, 561, 555, 548, 48, 1068,
+	4584, 4364, 1002, 4583, 5923, 4363, 4144, 4143, 936, 4581, 5922, 4361, 5921, 5920, 4141, 3924,
+	3923, 3921, 870, 4578, 5918, 4358, 5917, 5916, 4138, 5914, 5913, 5911, 3918, 3704, 3703, 3701,
+	3698, 804, 4574, 5908, 4354, 5907, 5906, 4134, 5904, 5903, 5901, 3914, 5898, 5897, 5895, 5892,
+	3694, 3484, 3483, 3481, 3478, 3474, 738, 4569, 5888, 4349, 5887, 5886, 4129, 5884, 5883, 5881,
+	3909, 5878, 5877, 5875, 5872, 3689, 5868, 5867, 5865, 5862, 5858, 3469, 3264, 3263, 3261, 3258,
+	3254, 3249, 672, 4563, 5853, 4343, 5852, 5851, 4123, 5849, 5848, 5846, 3903, 5843, 5842, 5840,
+	5837, 3683, 5833, 5832, 5830, 5827, 5823, 3463, 5818, 5817, 5815, 5812, 5808, 5803, 3243, 3044,
+	3043, 3041, 3038, 3034, 3029, 3023, 606, 4556, 5797, 4336, 5796, 5795, 4116, 5793, 5792, 5790,
+	3896, 5787, 5786, 5784, 5781, 3676, 5777, 5776, 5774, 5771, 5767, 3456, 5762, 5761, 5759, 5756,
+	5752, 5747, 3236, 5741, 5740, 5738, 5735, 5731, 5726, 5720, 3016, 2824, 2823, 2821, 2818, 2814,
+	2809, 2803, 2796, 540, 1522, 2688, 1511, 2687, 2686, 1500, 2684, 2683, 2681, 1489, 2678, 2677,
+	2675, 2672, 1478, 2668, 2667, 2665, 2662, 2658, 1467, 2653, 2652, 2650, 2647, 2643, 2638, 1456,
+	2632, 2631, 2629, 2626, 2622, 2617, 2611, 1445, 2604, 2603, 2601, 2598, 2594, 2589, 2583, 2576,
+	1434, 510, 509, 507, 504, 500, 495, 489, 482, 474, 36, 1059, 4548, 4328, 993, 4547,
+	5713, 4327, 4108, 4107, 927, 4545, 5712, 4325, 5711, 5710, 4105, 3888, 3887, 3885, 861, 4542,
+	5708, 4322, 5707, 5706, 4102, 5704, 5703, 5701, 3882, 3668, 3667, 3665, 3662, 795, 4538, 5698,
+	4318, 5697, 5696, 4098, 5694, 5693, 5691, 3878, 5688, 5687, 5685, 5682, 3658, 3448, 3447, 3445,
+	3442, 3438, 729, 4533, 5678, 4313, 5677, 5676, 4093, 5674, 5673, 5671, 3873, 5668, 5667, 5665,
+	5662, 3653, 5658, 5657, 5655, 5652, 5648, 3433, 3228, 3227, 3225, 3222, 3218, 3213, 663, 4527,
+	5643, 4307, 5642, 5641, 4087, 5639, 5638, 5636, 3867, 5633, 5632, 5630, 5627, 3647, 5623, 5622,
+	5620, 5617, 5613, 3427, 5608, 5607, 5605, 5602, 5598, 5593, 3207, 3008, 3007, 3005, 3002, 2998,
+	2993, 2987, 597, 4520, 5587, 4300, 5586, 5585, 4080, 5583, 5582, 5580, 3860, 5577, 5576, 5574,
+	5571, 3640, 5567, 5566, 5564, 5561, 5557, 3420, 5552, 5551, 5549, 5546, 5542, 5537, 3200, 5531,
+	5530, 5528, 5525, 5521, 5516, 5510, 2980, 2788, 2787, 2785, 2782, 2778, 2773, 2767, 2760, 531,
+	4512, 5503, 4292, 5502, 5501, 4072, 5499, 5498, 5496, 3852, 5493, 5492, 5490, 5487, 3632, 5483,
+	5482, 5480, 5477, 5473, 3412, 5468, 5467, 5465, 5462, 5458, 5453, 3192, 5447, 5446, 5444, 5441,
+	5437, 5432, 5426, 2972, 5419, 5418, 5416, 5413, 5409, 5404, 5398, 5391, 2752, 2568, 2567, 2565,
+	2562, 2558, 2553, 2547, 2540, 2532, 465, 1412, 2468, 1401, 2467, 2466, 1390, 2464, 2463, 2461,
+	1379, 2458, 2457, 2455, 2452, 1368, 2448, 2447, 2445, 2442, 2438, 1357, 2433, 2432, 2430, 2427,
+	2423, 2418, 1346, 2412, 2411, 2409, 2406, 2402, 2397, 2391, 1335, 2384, 2383, 2381, 2378, 2374,
+	2369, 2363, 2356, 1324, 2348, 2347, 2345, 2342, 2338, 2333, 2327, 2320, 2312, 1313, 444, 443,
+	441, 438, 434, 429, 423, 416, 408, 399, 24, 1049, 4503, 4283, 983, 4502, 5383, 4282,
+	4063, 4062, 917, 4500, 5382, 4280, 5381, 5380, 4060, 3843, 3842, 3840, 851, 4497, 5378, 4277,
+	5377, 5376, 4057, 5374, 5373, 5371, 3837, 3623, 3622, 3620, 3617, 785, 4493, 5368, 4273, 5367,
+	5366, 4053, 5364, 5363, 5361, 3833, 5358, 5357, 5355, 5352, 3613, 3403, 3402, 3400, 3397, 3393,
+	719, 4488, 5348, 4268, 5347, 5346, 4048, 5344, 5343, 5341, 3828, 5338, 5337, 5335, 5332, 3608,
+	5328, 5327, 5325, 5322, 5318, 3388, 3183, 3182, 3180, 3177, 3173, 3168, 653, 4482, 5313, 4262,
+	5312, 5311, 4042, 5309, 5308, 5306, 3822, 5303, 5302, 5300, 5297, 3602, 5293, 5292, 5290, 5287,
+	5283, 3382, 5278, 5277, 5275, 5272, 5268, 5263, 3162, 2963, 2962, 2960, 2957, 2953, 2948, 2942,
+	587, 4475, 5257, 4255, 5256, 5255, 4035, 5253, 5252, 5250, 3815, 5247, 5246, 5244, 5241, 3595,
+	5237, 5236, 5234, 5231, 5227, 3375, 5222, 5221, 5219, 5216, 5212, 5207, 3155, 5201, 5200, 5198,
+	5195, 5191, 5186, 5180, 2935, 2743, 2742, 2740, 2737, 2733, 2728, 2722, 2715, 521, 4467, 5173,
+	4247, 5172, 5171, 4027, 5169, 5168, 5166, 3807, 5163, 5162, 5160, 5157, 3587, 5153, 5152, 5150,
+	5147, 5143, 3367, 5138, 5137, 5135, 5132, 5128, 5123, 3147, 5117, 5116, 5114, 5111, 5107, 5102,
+	5096, 2927, 5089, 5088, 5086, 5083, 5079, 5074, 5068, 5061, 2707, 2523, 2522, 2520, 2517, 2513,
+	2508, 2502, 2495, 2487, 455, 4458, 5053, 4238, 5052, 5051, 4018, 5049, 5048, 5046, 3798, 5043,
+	5042, 5040, 5037, 3578, 5033, 5032, 5030, 5027, 5023, 3358, 5018, 5017, 5015, 5012, 5008, 5003,
+	3138, 4997, 4996, 4994, 4991, 4987, 4982, 4976, 2918, 4969, 4968, 4966, 4963, 4959, 4954, 4948,
+	4941, 2698, 4933, 4932, 4930, 4927, 4923, 4918, 4912, 4905, 4897, 2478, 2303, 2302, 2300, 2297,
+	2293, 2288, 2282, 2275, 2267, 2258, 389, 1291, 2248, 1280, 2247, 2246, 1269, 2244, 2243, 2241,
+	1258, 2238, 2237, 2235, 2232, 1247, 2228, 2227, 2225, 2222, 2218, 1236, 2213, 2212, 2210, 2207,
+	2203, 2198, 1225, 2192, 2191, 2189, 2186, 2182, 2177, 2171, 1214, 2164, 2163, 2161, 2158, 2154,
+	2149, 2143, 2136, 1203, 2128, 2127, 2125, 2122, 2118, 2113, 2107, 2100, 2092, 1192, 2083, 2082,
+	2080, 2077, 2073, 2068, 2062, 2055, 2047, 2038, 1181, 378, 377, 375, 372, 368, 363, 357,
+	350, 342, 333, 323, 12, 300, 2028, 2017, 288, 2027, 4888, 2016, 1995, 1994, 276, 2026,
+	4887, 2015, 4886, 4885, 1993, 1962, 1961, 1960, 264, 2025, 4884, 2014, 4883, 4882, 1992, 4881,
+	4880, 4879, 1959, 1918, 1917, 1916, 1915, 252, 2024, 4878, 2013, 4877, 4876, 1991, 4875, 4874,
+	4873, 1958, 4872, 4871, 4870, 4869, 1914, 1863, 1862, 1861, 1860, 1859, 240, 2023, 4868, 2012,
+	4867, 4866, 1990, 4865, 4864, 4863, 1957, 4862, 4861, 4860, 4859, 1913, 4858, 4857, 4856, 4855,
+	4854, 1858, 1797, 1796, 1795, 1794, 1793, 1792, 228, 2022, 4853, 2011, 4852, 4851, 1989, 4850,
+	4849, 4848, 1956, 4847, 4846, 4845, 4844, 1912, 4843, 4842, 4841, 4840, 4839, 1857, 4838, 4837,
+	4836, 4835, 4834, 4833, 1791, 1720, 1719, 1718, 1717, 1716, 1715, 1714, 216, 2021, 4832, 2010,
+	4831, 4830, 1988, 4829, 4828, 4827, 1955, 4826, 4825, 4824, 4823, 1911, 4822, 4821, 4820, 4819,
+	4818, 1856, 4817, 4816, 4815, 4814, 4813, 4812, 1790, 4811, 4810, 4809, 4808, 4807, 4806, 4805,
+	1713, 1632, 1631, 1630, 1629, 1628, 1627, 1626, 1625, 204, 2020, 4804, 2009, 4803, 4802, 1987,
+	4801, 4800, 4799, 1954, 4798, 4797, 4796, 4795, 1910, 4794, 4793, 4792, 4791, 4790, 1855, 4789,
+	4788, 4787, 4786, 4785, 4784, 1789, 4783, 4782, 4781, 4780, 4779, 4778, 4777, 1712, 4776, 4775,
+	4774, 4773, 4772, 4771, 4770, 4769, 1624, 1533, 1532, 1531, 1530, 1529, 1528, 1527, 1526, 1525,
+	192, 2019, 4768, 2008, 4767, 4766, 1986, 4765, 4764, 4763, 1953, 4762, 4761, 4760, 4759, 1909,
+	4758, 4757, 4756, 4755, 4754, 1854, 4753, 4752, 4751, 4750, 4749, 4748, 1788, 4747, 4746, 4745,
+	4744, 4743, 4742, 4741, 1711, 4740, 4739, 4738, 4737, 4736, 4735, 4734, 4733, 1623, 4732, 4731,
+	4730, 4729, 4728, 4727, 4726, 4725, 4724, 1524, 1423, 1422, 1421, 1420, 1419, 1418, 1417, 1416,
+	1415, 1414, 180, 2018, 4723, 2007, 4722, 4721, 1985, 4720, 4719, 4718, 1952, 4717, 4716, 4715,
+	4714, 1908, 4713, 4712, 4711, 4710, 4709, 1853, 4708, 4707, 4706, 4705, 4704, 4703, 1787, 4702,
+	4701, 4700, 4699, 4698, 4697, 4696, 1710, 4695, 4694, 4693, 4692, 4691, 4690, 4689, 4688, 1622,
+	4687, 4686, 4685, 4684, 4683, 4682, 4681, 4680, 4679, 1523, 4678, 4677, 4676, 4675, 4674, 4673,
+	4672, 4671, 4670, 4669, 1413, 1302, 1301, 1300, 1299, 1298, 1297, 1296, 1295, 1294, 1293, 1292,
+	168, 312, 1170, 311, 1169, 1168, 310, 1167, 1166, 1165, 309, 1164, 1163, 1162, 1161, 308,
+	1160, 1159, 1158, 1157, 1156, 307, 1155, 1154, 1153, 1152, 1151, 1150, 306, 1149, 1148, 1147,
+	1146, 1145, 1144, 1143, 305, 1142, 1141, 1140, 1139, 1138, 1137, 1136, 1135, 304, 1134, 1133,
+	1132, 1131, 1130, 1129, 1128, 1127, 1126, 303, 1125, 1124, 1123, 1122, 1121, 1120, 1119, 1118,
+	1117, 1116, 302, 1115, 1114, 1113, 1112, 1111, 1110, 1109, 1108, 1107, 1106, 1105, 301, 156,
+	155, 154, 153, 152, 151, 150, 149, 148, 147, 146, 145, 0,
+}
+
+// high4Table holds Lookup4CardHigh classes of 4-card hands, by rankIndex
+var high4Table = [1820]uint16{
+	2512, 2356, 1563, 2368, 2513, 2357, 705, 771, 2369, 1564, 837, 1565, 2380, 2381, 2514, 2358,
+	706, 772, 2370, 707, 1641, 773, 838, 839, 2382, 1566, 903, 1567, 904, 905, 1568, 2392,
+	2393, 2394, 2515, 2359, 708, 774, 2371, 709, 1, 775, 840, 841, 2383, 710, 2, 776,
+	3, 1642, 842, 906, 907, 908, 2395, 1569, 969, 1570, 970, 971, 1571, 972, 973, 974,
+	1572, 2404, 2405, 2406, 2407, 2516, 2360, 711, 777, 2372, 712, 4, 778, 843, 844, 2384,
+	713, 5, 779, 6, 7, 845, 909, 910, 911, 2396, 714, 8, 780, 9, 10, 846,
+	11, 12, 1643, 912, 975, 976, 977, 978, 2408, 1573, 1035, 1574, 1036, 1037, 1575, 1038,
+	1039, 1040, 1576, 1041, 1042, 1043, 1044, 1577, 2416, 2417, 2418, 2419, 2420, 2517, 2361, 715,
+	781, 2373, 716, 13, 782, 847, 848, 2385, 717, 14, 783, 15, 16, 849, 913, 914,
+	915, 2397, 718, 17, 784, 18, 19, 850, 20, 21, 22, 916, 979, 980, 981, 982,
+	2409, 719, 23, 785, 24, 25, 851, 26, 27, 28, 917, 29, 30, 31, 1644, 983,
+	1045, 1046, 1047, 1048, 1049, 2421, 1578, 1101, 1579, 1102, 1103, 1580, 1104, 1105, 1106, 1581,
+	1107, 1108, 1109, 1110, 1582, 1111, 1112, 1113, 1114, 1115, 1583, 2428, 2429, 2430, 2431, 2432,
+	2433, 2518, 2362, 720, 786, 2374, 721, 32, 787, 852, 853, 2386, 722, 33, 788, 34,
+	35, 854, 918, 919, 920, 2398, 723, 36, 789, 37, 38, 855, 39, 40, 41, 921,
+	984, 985, 986, 987, 2410, 724, 42, 790, 43, 44, 856, 45, 46, 47, 922, 48,
+	49, 50, 51, 988, 1050, 1051, 1052, 1053, 1054, 2422, 725, 52, 791, 53, 54, 857,
+	55, 56, 57, 923, 58, 59, 60, 61, 989, 62, 63, 64, 65, 1645, 1055, 1116,
+	1117, 1118, 1119, 1120, 1121, 2434, 1584, 1167, 1585, 1168, 1169, 1586, 1170, 1171, 1172, 1587,
+	1173, 1174, 1175, 1176, 1588, 1177, 1178, 1179, 1180, 1181, 1589, 1182, 1183, 1184, 1185, 1186,
+	1187, 1590, 2440, 2441, 2442, 2443, 2444, 2445, 2446, 2519, 2363, 726, 792, 2375, 727, 66,
+	793, 858, 859, 2387, 728, 67, 794, 68, 69, 860, 924, 925, 926, 2399, 729, 70,
+	795, 71, 72, 861, 73, 74, 75, 927, 990, 991, 992, 993, 2411, 730, 76, 796,
+	77, 78, 862, 79, 80, 81, 928, 82, 83, 84, 85, 994, 1056, 1057, 1058, 1059,
+	1060, 2423, 731, 86, 797, 87, 88, 863, 89, 90, 91, 929, 92, 93, 94, 95,
+	995, 96, 97, 98, 99, 100, 1061, 1122, 1123, 1124, 1125, 1126, 1127, 2435, 732, 101,
+	798, 102, 103, 864, 104, 105, 106, 930, 107, 108, 109, 110, 996, 111, 112, 113,
+	114, 115, 1062, 116, 117, 118, 119, 120, 1646, 1128, 1188, 1189, 1190, 1191, 1192, 1193,
+	1194, 2447, 1591, 1233, 1592, 1234, 1235, 1593, 1236, 1237, 1238, 1594, 1239, 1240, 1241, 1242,
+	1595, 1243, 1244, 1245, 1246, 1247, 1596, 1248, 1249, 1250, 1251, 1252, 1253, 1597, 1254, 1255,
+	1256, 1257, 1258, 1259, 1260, 1598, 2452, 2453, 2454, 2455, 2456, 2457, 2458, 2459, 2520, 2364,
+	733, 799, 2376, 734, 121, 800, 865, 866, 2388, 735, 122, 801, 123, 124, 867, 931,
+	932, 933, 2400, 736, 125, 802, 126, 127, 868, 128, 129, 130, 934, 997, 998, 999,
+	1000, 2412, 737, 131, 803, 132, 133, 869, 134, 135, 136, 935, 137, 138, 139, 140,
+	1001, 1063, 1064, 1065, 1066, 1067, 2424, 738, 141, 804, 142, 143, 870, 144, 145, 146,
+	936, 147, 148, 149, 150, 1002, 151, 152, 153, 154, 155, 1068, 1129, 1130, 1131, 1132,
+	1133, 1134, 2436, 739, 156, 805, 157, 158, 871, 159, 160, 161, 937, 162, 163, 164,
+	165, 1003, 166, 167, 168, 169, 170, 1069, 171, 172, 173, 174, 175, 176, 1135, 1195,
+	1196, 1197, 1198, 1199, 1200, 1201, 2448, 740, 177, 806, 178, 179, 872, 180, 181, 182,
+	938, 183, 184, 185, 186, 1004, 187, 188, 189, 190, 191, 1070, 192, 193, 194, 195,
+	196, 197, 1136, 198, 199, 200, 201, 202, 203, 1647, 1202, 1261, 1262, 1263, 1264, 1265,
+	1266, 1267, 1268, 2460, 1599, 1299, 1600, 1300, 1301, 1601, 1302, 1303, 1304, 1602, 1305, 1306,
+	1307, 1308, 1603, 1309, 1310, 1311, 1312, 1313, 1604, 1314, 1315, 1316, 1317, 1318, 1319, 1605,
+	1320, 1321, 1322, 1323, 1324, 1325, 1326, 1606, 1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334,
+	1607, 2464, 2465, 2466, 2467, 2468, 2469, 2470, 2471, 2472, 2521, 2365, 741, 807, 2377, 742,
+	204, 808, 873, 874, 2389, 743, 205, 809, 206, 207, 875, 939, 940, 941, 2401, 744,
+	208, 810, 209, 210, 876, 211, 212, 213, 942, 1005, 1006, 1007, 1008, 2413, 745, 214,
+	811, 215, 216, 877, 217, 218, 219, 943, 220, 221, 222, 223, 1009, 1071, 1072, 1073,
+	1074, 1075, 2425, 746, 224, 812, 225, 226, 878, 227, 228, 229, 944, 230, 231, 232,
+	233, 1010, 234, 235, 236, 237, 238, 1076, 1137, 1138, 1139, 1140, 1141, 1142, 2437, 747,
+	239, 813, 240, 241, 879, 242, 243, 244, 945, 245, 246, 247, 248, 1011, 249, 250,
+	251, 252, 253, 1077, 254, 255, 256, 257, 258, 259, 1143, 1203, 1204, 1205, 1206, 1207,
+	1208, 1209, 2449, 748, 260, 814, 261, 262, 880, 263, 264, 265, 946, 266, 267, 268,
+	269, 1012, 270, 271, 272, 273, 274, 1078, 275, 276, 277, 278, 279, 280, 1144, 281,
+	282, 283, 284, 285, 286, 287, 1210, 1269, 1270, 1271, 1272, 1273, 1274, 1275, 1276, 2461,
+	749, 288, 815, 289, 290, 881, 291, 292, 293, 947, 294, 295, 296, 297, 1013, 298,
+	299, 300, 301, 302, 1079, 303, 304, 305, 306, 307, 308, 1145, 309, 310, 311, 312,
+	313, 314, 315, 1211, 316, 317, 318, 319, 320, 321, 322, 1648, 1277, 1335, 1336, 1337,
+	1338, 1339, 1340, 1341, 1342, 1343, 2473, 1608, 1365, 1609, 1366, 1367, 1610, 1368, 1369, 1370,
+	1611, 1371, 1372, 1373, 1374, 1612, 1375, 1376, 1377, 1378, 1379, 1613, 1380, 1381, 1382, 1383,
+	1384, 1385, 1614, 1386, 1387, 1388, 1389, 1390, 1391, 1392, 1615, 1393, 1394, 1395, 1396, 1397,
+	1398, 1399, 1400, 1616, 1401, 1402, 1403, 1404, 1405, 1406, 1407, 1408, 1409, 1617, 2476, 2477,
+	2478, 2479, 2480, 2481, 2482, 2483, 2484, 2485, 2522, 2366, 750, 816, 2378, 751, 323, 817,
+	882, 883, 2390, 752, 324, 818, 325, 326, 884, 948, 949, 950, 2402, 753, 327, 819,
+	328, 329, 885, 330, 331, 332, 951, 1014, 1015, 1016, 1017, 2414, 754, 333, 820, 334,
+	335, 886, 336, 337, 338, 952, 339, 340, 341, 342, 1018, 1080, 1081, 1082, 1083, 1084,
+	2426, 755, 343, 821, 344, 345, 887, 346, 347, 348, 953, 349, 350, 351, 352, 1019,
+	353, 354, 355, 356, 357, 1085, 1146, 1147, 1148, 1149, 1150, 1151, 2438, 756, 358, 822,
+	359, 360, 888, 361, 362, 363, 954, 364, 365, 366, 367, 1020, 368, 369, 370, 371,
+	372, 1086, 373, 374, 375, 376, 377, 378, 1152, 1212, 1213, 1214, 1215, 1216, 1217, 1218,
+	2450, 757, 379, 823, 380, 381, 889, 382, 383, 384, 955, 385, 386, 387, 388, 1021,
+	389, 390, 391, 392, 393, 1087, 394, 395, 396, 397, 398, 399, 1153, 400, 401, 402,
+	403, 404, 405, 406, 1219, 1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285, 2462, 758, 407,
+	824, 408, 409, 890, 410, 411, 412, 956, 413, 414, 415, 416, 1022, 417, 418, 419,
+	420, 421, 1088, 422, 423, 424, 425, 426, 427, 1154, 428, 429, 430, 431, 432, 433,
+	434, 1220, 435, 436, 437, 438, 439, 440, 441, 442, 1286, 1344, 1345, 1346, 1347, 1348,
+	1349, 1350, 1351, 1352, 2474, 759, 443, 825, 444, 445, 891, 446, 447, 448, 957, 449,
+	450, 451, 452, 1023, 453, 454, 455, 456, 457, 1089, 458, 459, 460, 461, 462, 463,
+	1155, 464, 465, 466, 467, 468, 469, 470, 1221, 471, 472, 473, 474, 475, 476, 477,
+	478, 1287, 479, 480, 481, 482, 483, 484, 485, 486, 1649, 1353, 1410, 1411, 1412, 1413,
+	1414, 1415, 1416, 1417, 1418, 1419, 2486, 1618, 1431, 1619, 1432, 1433, 1620, 1434, 1435, 1436,
+	1621, 1437, 1438, 1439, 1440, 1622, 1441, 1442, 1443, 1444, 1445, 1623, 1446, 1447, 1448, 1449,
+	1450, 1451, 1624, 1452, 1453, 1454, 1455, 1456, 1457, 1458, 1625, 1459, 1460, 1461, 1462, 1463,
+	1464, 1465, 1466, 1626, 1467, 1468, 1469, 1470, 1471, 1472, 1473, 1474, 1475, 1627, 1476, 1477,
+	1478, 1479, 1480, 1481, 1482, 1483, 1484, 1485, 1628, 2488, 2489, 2490, 2491, 2492, 2493, 2494,
+	2495, 2496, 2497, 2498, 2523, 2367, 760, 826, 2379, 761, 1650, 827, 892, 893, 2391, 762,
+	487, 828, 488, 489, 894, 958, 959, 960, 2403, 763, 490, 829, 491, 492, 895, 493,
+	494, 495, 961, 1024, 1025, 1026, 1027, 2415, 764, 496, 830, 497, 498, 896, 499, 500,
+	501, 962, 502, 503, 504, 505, 1028, 1090, 1091, 1092, 1093, 1094, 2427, 765, 506, 831,
+	507, 508, 897, 509, 510, 511, 963, 512, 513, 514, 515, 1029, 516, 517, 518, 519,
+	520, 1095, 1156, 1157, 1158, 1159, 1160, 1161, 2439, 766, 521, 832, 522, 523, 898, 524,
+	525, 526, 964, 527, 528, 529, 530, 1030, 531, 532, 533, 534, 535, 1096, 536, 537,
+	538, 539, 540, 541, 1162, 1222, 1223, 1224, 1225, 1226, 1227, 1228, 2451, 767, 542, 833,
+	543, 544, 899, 545, 546, 547, 965, 548, 549, 550, 551, 1031, 552, 553, 554, 555,
+	556, 1097, 557, 558, 559, 560, 561, 562, 1163, 563, 564, 565, 566, 567, 568, 569,
+	1229, 1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295, 2463, 768, 570, 834, 571, 572, 900,
+	573, 574, 575, 966, 576, 577, 578, 579, 1032, 580, 581, 582, 583, 584, 1098, 585,
+	586, 587, 588, 589, 590, 1164, 591, 592, 593, 594, 595, 596, 597, 1230, 598, 599,
+	600, 601, 602, 603, 604, 605, 1296, 1354, 1355, 1356, 1357, 1358, 1359, 1360, 1361, 1362,
+	2475, 769, 606, 835, 607, 608, 901, 609, 610, 611, 967, 612, 613, 614, 615, 1033,
+	616, 617, 618, 619, 620, 1099, 621, 622, 623, 624, 625, 626, 1165, 627, 628, 629,
+	630, 631, 632, 633, 1231, 634, 635, 636, 637, 638, 639, 640, 641, 1297, 642, 643,
+	644, 645, 646, 647, 648, 649, 650, 1363, 1420, 1421, 1422, 1423, 1424, 1425, 1426, 1427,
+	1428, 1429, 2487, 770, 651, 836, 652, 653, 902, 654, 655, 656, 968, 657, 658, 659,
+	660, 1034, 661, 662, 663, 664, 665, 1100, 666, 667, 668, 669, 670, 671, 1166, 672,
+	673, 674, 675, 676, 677, 678, 1232, 679, 680, 681, 682, 683, 684, 685, 686, 1298,
+	687, 688, 689, 690, 691, 692, 693, 694, 695, 1364, 696, 697, 698, 699, 700, 701,
+	702, 703, 704, 1651, 1430, 1486, 1487, 1488, 1489, 1490, 1491, 1492, 1493, 1494, 1495, 1496,
+	2499, 1629, 1497, 1630, 1498, 1499, 1631, 1500, 1501, 1502, 1632, 1503, 1504, 1505, 1506, 1633,
+	1507, 1508, 1509, 1510, 1511, 1634, 1512, 1513, 1514, 1515, 1516, 1517, 1635, 1518, 1519, 1520,
+	1521, 1522, 1523, 1524, 1636, 1525, 1526, 1527, 1528, 1529, 1530, 1531, 1532, 1637, 1533, 1534,
+	1535, 1536, 1537, 1538, 1539, 1540, 1541, 1638, 1542, 1543, 1544, 1545, 1546, 1547, 1548, 1549,
+	1550, 1551, 1639, 1552, 1553, 1554, 1555, 1556, 1557, 1558, 1559, 1560, 1561, 1562, 1640, 2500,
+	2501, 2502, 2503, 2504, 2505, 2506, 2507, 2508, 2509, 2510, 2511, 2524,
+}
+
+// high4FlushTable holds Lookup4CardHigh classes of 4-card flushes, by rankIndex
+var high4FlushTable = [1820]uint16{
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2525, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1652, 0, 0, 0, 0, 0, 1653, 0,
+	1654, 2526, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1655, 0, 0, 0, 0,
+	0, 1656, 0, 1657, 1658, 0, 0, 0, 0, 0, 0, 1659, 0, 1660, 1661, 0,
+	1662, 1663, 2527, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1664, 0, 0, 0, 0, 0, 1665, 0, 1666, 1667, 0, 0, 0,
+	0, 0, 0, 1668, 0, 1669, 1670, 0, 1671, 1672, 1673, 0, 0, 0, 0, 0,
+	0, 0, 1674, 0, 1675, 1676, 0, 1677, 1678, 1679, 0, 1680, 1681, 1682, 2528, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1683, 0, 0, 0, 0, 0, 1684, 0, 1685,
+	1686, 0, 0, 0, 0, 0, 0, 1687, 0, 1688, 1689, 0, 1690, 1691, 1692, 0,
+	0, 0, 0, 0, 0, 0, 1693, 0, 1694, 1695, 0, 1696, 1697, 1698, 0, 1699,
+	1700, 1701, 1702, 0, 0, 0, 0, 0, 0, 0, 0, 1703, 0, 1704, 1705, 0,
+	1706, 1707, 1708, 0, 1709, 1710, 1711, 1712, 0, 1713, 1714, 1715, 1716, 2529, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1717,
+	0, 0, 0, 0, 0, 1718, 0, 1719, 1720, 0, 0, 0, 0, 0, 0, 1721,
+	0, 1722, 1723, 0, 1724, 1725, 1726, 0, 0, 0, 0, 0, 0, 0, 1727, 0,
+	1728, 1729, 0, 1730, 1731, 1732, 0, 1733, 1734, 1735, 1736, 0, 0, 0, 0, 0,
+	0, 0, 0, 1737, 0, 1738, 1739, 0, 1740, 1741, 1742, 0, 1743, 1744, 1745, 1746,
+	0, 1747, 1748, 1749, 1750, 1751, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1752,
+	0, 1753, 1754, 0, 1755, 1756, 1757, 0, 1758, 1759, 1760, 1761, 0, 1762, 1763, 1764,
+	1765, 1766, 0, 1767, 1768, 1769, 1770, 1771, 2530, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1772, 0, 0, 0, 0, 0, 1773, 0, 1774, 1775, 0, 0,
+	0, 0, 0, 0, 1776, 0, 1777, 1778, 0, 1779, 1780, 1781, 0, 0, 0, 0,
+	0, 0, 0, 1782, 0, 1783, 1784, 0, 1785, 1786, 1787, 0, 1788, 1789, 1790, 1791,
+	0, 0, 0, 0, 0, 0, 0, 0, 1792, 0, 1793, 1794, 0, 1795, 1796, 1797,
+	0, 1798, 1799, 1800, 1801, 0, 1802, 1803, 1804, 1805, 1806, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1807, 0, 1808, 1809, 0, 1810, 1811, 1812, 0, 1813, 1814, 1815,
+	1816, 0, 1817, 1818, 1819, 1820, 1821, 0, 1822, 1823, 1824, 1825, 1826, 1827, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1828, 0, 1829, 1830, 0, 1831, 1832, 1833,
+	0, 1834, 1835, 1836, 1837, 0, 1838, 1839, 1840, 1841, 1842, 0, 1843, 1844, 1845, 1846,
+	1847, 1848, 0, 1849, 1850, 1851, 1852, 1853, 1854, 2531, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1855, 0, 0, 0, 0, 0, 1856, 0, 1857, 1858, 0, 0, 0, 0, 0, 0,
+	1859, 0, 1860, 1861, 0, 1862, 1863, 1864, 0, 0, 0, 0, 0, 0, 0, 1865,
+	0, 1866, 1867, 0, 1868, 1869, 1870, 0, 1871, 1872, 1873, 1874, 0, 0, 0, 0,
+	0, 0, 0, 0, 1875, 0, 1876, 1877, 0, 1878, 1879, 1880, 0, 1881, 1882, 1883,
+	1884, 0, 1885, 1886, 1887, 1888, 1889, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1890, 0, 1891, 1892, 0, 1893, 1894, 1895, 0, 1896, 1897, 1898, 1899, 0, 1900, 1901,
+	1902, 1903, 1904, 0, 1905, 1906, 1907, 1908, 1909, 1910, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1911, 0, 1912, 1913, 0, 1914, 1915, 1916, 0, 1917, 1918, 1919,
+	1920, 0, 1921, 1922, 1923, 1924, 1925, 0, 1926, 1927, 1928, 1929, 1930, 1931, 0, 1932,
+	1933, 1934, 1935, 1936, 1937, 1938, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1939, 0, 1940, 1941, 0, 1942, 1943, 1944, 0, 1945, 1946, 1947, 1948, 0, 1949,
+	1950, 1951, 1952, 1953, 0, 1954, 1955, 1956, 1957, 1958, 1959, 0, 1960, 1961, 1962, 1963,
+	1964, 1965, 1966, 0, 1967, 1968, 1969, 1970, 1971, 1972, 1973, 2532, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1974, 0,
+	0, 0, 0, 0, 1975, 0, 1976, 1977, 0, 0, 0, 0, 0, 0, 1978, 0,
+	1979, 1980, 0, 1981, 1982, 1983, 0, 0, 0, 0, 0, 0, 0, 1984, 0, 1985,
+	1986, 0, 1987, 1988, 1989, 0, 1990, 1991, 1992, 1993, 0, 0, 0, 0, 0, 0,
+	0, 0, 1994, 0, 1995, 1996, 0, 1997, 1998, 1999, 0, 2000, 2001, 2002, 2003, 0,
+	2004, 2005, 2006, 2007, 2008, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2009, 0,
+	2010, 2011, 0, 2012, 2013, 2014, 0, 2015, 2016, 2017, 2018, 0, 2019, 2020, 2021, 2022,
+	2023, 0, 2024, 2025, 2026, 2027, 2028, 2029, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2030, 0, 2031, 2032, 0, 2033, 2034, 2035, 0, 2036, 2037, 2038, 2039, 0,
+	2040, 2041, 2042, 2043, 2044, 0, 2045, 2046, 2047, 2048, 2049, 2050, 0, 2051, 2052, 2053,
+	2054, 2055, 2056, 2057, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2058,
+	0, 2059, 2060, 0, 2061, 2062, 2063, 0, 2064, 2065, 2066, 2067, 0, 2068, 2069, 2070,
+	2071, 2072, 0, 2073, 2074, 2075, 2076, 2077, 2078, 0, 2079, 2080, 2081, 2082, 2083, 2084,
+	2085, 0, 2086, 2087, 2088, 2089, 2090, 2091, 2092, 2093, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2094, 0, 2095, 2096, 0, 2097, 2098, 2099, 0, 2100,
+	2101, 2102, 2103, 0, 2104, 2105, 2106, 2107, 2108, 0, 2109, 2110, 2111, 2112, 2113, 2114,
+	0, 2115, 2116, 2117, 2118, 2119, 2120, 2121, 0, 2122, 2123, 2124, 2125, 2126, 2127, 2128,
+	2129, 0, 2130, 2131, 2132, 2133, 2134, 2135, 2136, 2137, 2533, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2534, 0, 0, 0, 0, 0,
+	2138, 0, 2139, 2140, 0, 0, 0, 0, 0, 0, 2141, 0, 2142, 2143, 0, 2144,
+	2145, 2146, 0, 0, 0, 0, 0, 0, 0, 2147, 0, 2148, 2149, 0, 2150, 2151,
+	2152, 0, 2153, 2154, 2155, 2156, 0, 0, 0, 0, 0, 0, 0, 0, 2157, 0,
+	2158, 2159, 0, 2160, 2161, 2162, 0, 2163, 2164, 2165, 2166, 0, 2167, 2168, 2169, 2170,
+	2171, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2172, 0, 2173, 2174, 0, 2175,
+	2176, 2177, 0, 2178, 2179, 2180, 2181, 0, 2182, 2183, 2184, 2185, 2186, 0, 2187, 2188,
+	2189, 2190, 2191, 2192, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2193, 0,
+	2194, 2195, 0, 2196, 2197, 2198, 0, 2199, 2200, 2201, 2202, 0, 2203, 2204, 2205, 2206,
+	2207, 0, 2208, 2209, 2210, 2211, 2212, 2213, 0, 2214, 2215, 2216, 2217, 2218, 2219, 2220,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2221, 0, 2222, 2223, 0,
+	2224, 2225, 2226, 0, 2227, 2228, 2229, 2230, 0, 2231, 2232, 2233, 2234, 2235, 0, 2236,
+	2237, 2238, 2239, 2240, 2241, 0, 2242, 2243, 2244, 2245, 2246, 2247, 2248, 0, 2249, 2250,
+	2251, 2252, 2253, 2254, 2255, 2256, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2257, 0, 2258, 2259, 0, 2260, 2261, 2262, 0, 2263, 2264, 2265, 2266, 0,
+	2267, 2268, 2269, 2270, 2271, 0, 2272, 2273, 2274, 2275, 2276, 2277, 0, 2278, 2279, 2280,
+	2281, 2282, 2283, 2284, 0, 2285, 2286, 2287, 2288, 2289, 2290, 2291, 2292, 0, 2293, 2294,
+	2295, 2296, 2297, 2298, 2299, 2300, 2301, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2302, 0, 2303, 2304, 0, 2305, 2306, 2307, 0, 2308, 2309, 2310,
+	2311, 0, 2312, 2313, 2314, 2315, 2316, 0, 2317, 2318, 2319, 2320, 2321, 2322, 0, 2323,
+	2324, 2325, 2326, 2327, 2328, 2329, 0, 2330, 2331, 2332, 2333, 2334, 2335, 2336, 2337, 0,
+	2338, 2339, 2340, 2341, 2342, 2343, 2344, 2345, 2346, 0, 2347, 2348, 2349, 2350, 2351, 2352,
+	2353, 2354, 2355, 2535, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+}
+
+// badugiTable holds LookupBadugi classes of badugis, by rank mask
+var badugiTable = [8192]uint16{
+	0, 12, 11, 89, 10, 87, 86, 374, 9, 84, 83, 371, 82, 369, 368, 1088,
+	8, 80, 79, 365, 78, 363, 362, 1084, 77, 360, 359, 1081, 358, 1079, 1078, 0,
+	7, 75, 74, 355, 73, 353, 352, 1074, 72, 350, 349, 1071, 348, 1069, 1068, 0,
+	71, 346, 345, 1065, 344, 1063, 1062, 0, 343, 1060, 1059, 0, 1058, 0, 0, 0,
+	6, 69, 68, 340, 67, 338, 337, 1054, 66, 335, 334, 1051, 333, 1049, 1048, 0,
+	65, 331, 330, 1045, 329, 1043, 1042, 0, 328, 1040, 1039, 0, 1038, 0, 0, 0,
+	64, 326, 325, 1035, 324, 1033, 1032, 0, 323, 1030, 1029, 0, 1028, 0, 0, 0,
+	322, 1026, 1025, 0, 1024, 0, 0, 0, 1023, 0, 0, 0, 0, 0, 0, 0,
+	5, 62, 61, 319, 60, 317, 316, 1019, 59, 314, 313, 1016, 312, 1014, 1013, 0,
+	58, 310, 309, 1010, 308, 1008, 1007, 0, 307, 1005, 1004, 0, 1003, 0, 0, 0,
+	57, 305, 304, 1000, 303, 998, 997, 0, 302, 995, 994, 0, 993, 0, 0, 0,
+	301, 991, 990, 0, 989, 0, 0, 0, 988, 0, 0, 0, 0, 0, 0, 0,
+	56, 299, 298, 985, 297, 983, 982, 0, 296, 980, 979, 0, 978, 0, 0, 0,
+	295, 976, 975, 0, 974, 0, 0, 0, 973, 0, 0, 0, 0, 0, 0, 0,
+	294, 971, 970, 0, 969, 0, 0, 0, 968, 0, 0, 0, 0, 0, 0, 0,
+	967, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	4, 54, 53, 291, 52, 289, 288, 963, 51, 286, 285, 960, 284, 958, 957, 0,
+	50, 282, 281, 954, 280, 952, 951, 0, 279, 949, 948, 0, 947, 0, 0, 0,
+	49, 277, 276, 944, 275, 942, 941, 0, 274, 939, 938, 0, 937, 0, 0, 0,
+	273, 935, 934, 0, 933, 0, 0, 0, 932, 0, 0, 0, 0, 0, 0, 0,
+	48, 271, 270, 929, 269, 927, 926, 0, 268, 924, 923, 0, 922, 0, 0, 0,
+	267, 920, 919, 0, 918, 0, 0, 0, 917, 0, 0, 0, 0, 0, 0, 0,
+	266, 915, 914, 0, 913, 0, 0, 0, 912, 0, 0, 0, 0, 0, 0, 0,
+	911, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	47, 264, 263, 908, 262, 906, 905, 0, 261, 903, 902, 0, 901, 0, 0, 0,
+	260, 899, 898, 0, 897, 0, 0, 0, 896, 0, 0, 0, 0, 0, 0, 0,
+	259, 894, 893, 0, 892, 0, 0, 0, 891, 0, 0, 0, 0, 0, 0, 0,
+	890, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	258, 888, 887, 0, 886, 0, 0, 0, 885, 0, 0, 0, 0, 0, 0, 0,
+	884, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	883, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	3, 45, 44, 255, 43, 253, 252, 879, 42, 250, 249, 876, 248, 874, 873, 0,
+	41, 246, 245, 870, 244, 868, 867, 0, 243, 865, 864, 0, 863, 0, 0, 0,
+	40, 241, 240, 860, 239, 858, 857, 0, 238, 855, 854, 0, 853, 0, 0, 0,
+	237, 851, 850, 0, 849, 0, 0, 0, 848, 0, 0, 0, 0, 0, 0, 0,
+	39, 235, 234, 845, 233, 843, 842, 0, 232, 840, 839, 0, 838, 0, 0, 0,
+	231, 836, 835, 0, 834, 0, 0, 0, 833, 0, 0, 0, 0, 0, 0, 0,
+	230, 831, 830, 0, 829, 0, 0, 0, 828, 0, 0, 0, 0, 0, 0, 0,
+	827, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	38, 228, 227, 824, 226, 822, 821, 0, 225, 819, 818, 0, 817, 0, 0, 0,
+	224, 815, 814, 0, 813, 0, 0, 0, 812, 0, 0, 0, 0, 0, 0, 0,
+	223, 810, 809, 0, 808, 0, 0, 0, 807, 0, 0, 0, 0, 0, 0, 0,
+	806, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	222, 804, 803, 0, 802, 0, 0, 0, 801, 0, 0, 0, 0, 0, 0, 0,
+	800, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	799, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	37, 220, 219, 796, 218, 794, 793, 0, 217, 791, 790, 0, 789, 0, 0, 0,
+	216, 787, 786, 0, 785, 0, 0, 0, 784, 0, 0, 0, 0, 0, 0, 0,
+	215, 782, 781, 0, 780, 0, 0, 0, 779, 0, 0, 0, 0, 0, 0, 0,
+	778, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	214, 776, 775, 0, 774, 0, 0, 0, 773, 0, 0, 0, 0, 0, 0, 0,
+	772, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	771, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	213, 769, 768, 0, 767, 0, 0, 0, 766, 0, 0, 0, 0, 0, 0, 0,
+	765, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	764, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	763, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2, 35, 34, 210, 33, 208, 207, 759, 32, 205, 204, 756, 203, 754, 753, 0,
+	31, 201, 200, 750, 199, 748, 747, 0, 198, 745, 744, 0, 743, 0, 0, 0,
+	30, 196, 195, 740, 194, 738, 737, 0, 193, 735, 734, 0, 733, 0, 0, 0,
+	192, 731, 730, 0, 729, 0, 0, 0, 728, 0, 0, 0, 0, 0, 0, 0,
+	29, 190, 189, 725, 188, 723, 722, 0, 187, 720, 719, 0, 718, 0, 0, 0,
+	186, 716, 715, 0, 714, 0, 0, 0, 713, 0, 0, 0, 0, 0, 0, 0,
+	185, 711, 710, 0, 709, 0, 0, 0, 708, 0, 0, 0, 0, 0, 0, 0,
+	707, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	28, 183, 182, 704, 181, 702, 701, 0, 180, 699, 698, 0, 697, 0, 0, 0,
+	179, 695, 694, 0, 693, 0, 0, 0, 692, 0, 0, 0, 0, 0, 0, 0,
+	178, 690, 689, 0, 688, 0, 0, 0, 687, 0, 0, 0, 0, 0, 0, 0,
+	686, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	177, 684, 683, 0, 682, 0, 0, 0, 681, 0, 0, 0, 0, 0, 0, 0,
+	680, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	679, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	27, 175, 174, 676, 173, 674, 673, 0, 172, 671, 670, 0, 669, 0, 0, 0,
+	171, 667, 666, 0, 665, 0, 0, 0, 664, 0, 0, 0, 0, 0, 0, 0,
+	170, 662, 661, 0, 660, 0, 0, 0, 659, 0, 0, 0, 0, 0, 0, 0,
+	658, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	169, 656, 655, 0, 654, 0, 0, 0, 653, 0, 0, 0, 0, 0, 0, 0,
+	652, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	651, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	168, 649, 648, 0, 647, 0, 0, 0, 646, 0, 0, 0, 0, 0, 0, 0,
+	645, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	644, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	643, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	26, 166, 165, 640, 164, 638, 637, 0, 163, 635, 634, 0, 633, 0, 0, 0,
+	162, 631, 630, 0, 629, 0, 0, 0, 628, 0, 0, 0, 0, 0, 0, 0,
+	161, 626, 625, 0, 624, 0, 0, 0, 623, 0, 0, 0, 0, 0, 0, 0,
+	622, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	160, 620, 619, 0, 618, 0, 0, 0, 617, 0, 0, 0, 0, 0, 0, 0,
+	616, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	615, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	159, 613, 612, 0, 611, 0, 0, 0, 610, 0, 0, 0, 0, 0, 0, 0,
+	609, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	608, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	607, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	158, 605, 604, 0, 603, 0, 0, 0, 602, 0, 0, 0, 0, 0, 0, 0,
+	601, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	600, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	599, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	598, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1, 24, 23, 155, 22, 153, 152, 594, 21, 150, 149, 591, 148, 589, 588, 0,
+	20, 146, 145, 585, 144, 583, 582, 0, 143, 580, 579, 0, 578, 0, 0, 0,
+	19, 141, 140, 575, 139, 573, 572, 0, 138, 570, 569, 0, 568, 0, 0, 0,
+	137, 566, 565, 0, 564, 0, 0, 0, 563, 0, 0, 0, 0, 0, 0, 0,
+	18, 135, 134, 560, 133, 558, 557, 0, 132, 555, 554, 0, 553, 0, 0, 0,
+	131, 551, 550, 0, 549, 0, 0, 0, 548, 0, 0, 0, 0, 0, 0, 0,
+	130, 546, 545, 0, 544, 0, 0, 0, 543, 0, 0, 0, 0, 0, 0, 0,
+	542, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	17, 128, 127, 539, 126, 537, 536, 0, 125, 534, 533, 0, 532, 0, 0, 0,
+	124, 530, 529, 0, 528, 0, 0, 0, 527, 0, 0, 0, 0, 0, 0, 0,
+	123, 525, 524, 0, 523, 0, 0, 0, 522, 0, 0, 0, 0, 0, 0, 0,
+	521, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	122, 519, 518, 0, 517, 0, 0, 0, 516, 0, 0, 0, 0, 0, 0, 0,
+	515, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	514, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	16, 120, 119, 511, 118, 509, 508, 0, 117, 506, 505, 0, 504, 0, 0, 0,
+	116, 502, 501, 0, 500, 0, 0, 0, 499, 0, 0, 0, 0, 0, 0, 0,
+	115, 497, 496, 0, 495, 0, 0, 0, 494, 0, 0, 0, 0, 0, 0, 0,
+	493, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	114, 491, 490, 0, 489, 0, 0, 0, 488, 0, 0, 0, 0, 0, 0, 0,
+	487, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	486, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	113, 484, 483, 0, 482, 0, 0, 0, 481, 0, 0, 0, 0, 0, 0, 0,
+	480, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	479, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	478, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	15, 111, 110, 475, 109, 473, 472, 0, 108, 470, 469, 0, 468, 0, 0, 0,
+	107, 466, 465, 0, 464, 0, 0, 0, 463, 0, 0, 0, 0, 0, 0, 0,
+	106, 461, 460, 0, 459, 0, 0, 0, 458, 0, 0, 0, 0, 0, 0, 0,
+	457, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	105, 455, 454, 0, 453, 0, 0, 0, 452, 0, 0, 0, 0, 0, 0, 0,
+	451, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	450, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	104, 448, 447, 0, 446, 0, 0, 0, 445, 0, 0, 0, 0, 0, 0, 0,
+	444, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	443, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	442, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	103, 440, 439, 0, 438, 0, 0, 0, 437, 0, 0, 0, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	435, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	434, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	433, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	14, 101, 100, 430, 99, 428, 427, 0, 98, 425, 424, 0, 423, 0, 0, 0,
+	97, 421, 420, 0, 419, 0, 0, 0, 418, 0, 0, 0, 0, 0, 0, 0,
+	96, 416, 415, 0, 414, 0, 0, 0, 413, 0, 0, 0, 0, 0, 0, 0,
+	412, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	95, 410, 409, 0, 408, 0, 0, 0, 407, 0, 0, 0, 0, 0, 0, 0,
+	406, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	405, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	94, 403, 402, 0, 401, 0, 0, 0, 400, 0, 0, 0, 0, 0, 0, 0,
+	399, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	398, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	397, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	93, 395, 394, 0, 393, 0, 0, 0, 392, 0, 0, 0, 0, 0, 0, 0,
+	391, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	390, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	389, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	388, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	92, 386, 385, 0, 384, 0, 0, 0, 383, 0, 0, 0, 0, 0, 0, 0,
+	382, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	381, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	380, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	379, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	378, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	13, 91, 90, 377, 88, 376, 375, 1092, 85, 373, 372, 1091, 370, 1090, 1089, 0,
+	81, 367, 366, 1087, 364, 1086, 1085, 0, 361, 1083, 1082, 0, 1080, 0, 0, 0,
+	76, 357, 356, 1077, 354, 1076, 1075, 0, 351, 1073, 1072, 0, 1070, 0, 0, 0,
+	347, 1067, 1066, 0, 1064, 0, 0, 0, 1061, 0, 0, 0, 0, 0, 0, 0,
+	70, 342, 341, 1057, 339, 1056, 1055, 0, 336, 1053, 1052, 0, 1050, 0, 0, 0,
+	332, 1047, 1046, 0, 1044, 0, 0, 0, 1041, 0, 0, 0, 0, 0, 0, 0,
+	327, 1037, 1036, 0, 1034, 0, 0, 0, 1031, 0, 0, 0, 0, 0, 0, 0,
+	1027, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	63, 321, 320, 1022, 318, 1021, 1020, 0, 315, 1018, 1017, 0, 1015, 0, 0, 0,
+	311, 1012, 1011, 0, 1009, 0, 0, 0, 1006, 0, 0, 0, 0, 0, 0, 0,
+	306, 1002, 1001, 0, 999, 0, 0, 0, 996, 0, 0, 0, 0, 0, 0, 0,
+	992, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	300, 987, 986, 0, 984, 0, 0, 0, 981, 0, 0, 0, 0, 0, 0, 0,
+	977, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	972, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	55, 293, 292, 966, 290, 965, 964, 0, 287, 962, 961, 0, 959, 0, 0, 0,
+	283, 956, 955, 0, 953, 0, 0, 0, 950, 0, 0, 0, 0, 0, 0, 0,
+	278, 946, 945, 0, 943, 0, 0, 0, 940, 0, 0, 0, 0, 0, 0, 0,
+	936, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	272, 931, 930, 0, 928, 0, 0, 0, 925, 0, 0, 0, 0, 0, 0, 0,
+	921, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	916, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	265, 910, 909, 0, 907, 0, 0, 0, 904, 0, 0, 0, 0, 0, 0, 0,
+	900, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	895, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	889, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	46, 257, 256, 882, 254, 881, 880, 0, 251, 878, 877, 0, 875, 0, 0, 0,
+	247, 872, 871, 0, 869, 0, 0, 0, 866, 0, 0, 0, 0, 0, 0, 0,
+	242, 862, 861, 0, 859, 0, 0, 0, 856, 0, 0, 0, 0, 0, 0, 0,
+	852, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	236, 847, 846, 0, 844, 0, 0, 0, 841, 0, 0, 0, 0, 0, 0, 0,
+	837, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	832, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	229, 826, 825, 0, 823, 0, 0, 0, 820, 0, 0, 0, 0, 0, 0, 0,
+	816, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	811, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	805, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	221, 798, 797, 0, 795, 0, 0, 0, 792, 0, 0, 0, 0, 0, 0, 0,
+	788, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	783, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	777, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	770, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	36, 212, 211, 762, 209, 761, 760, 0, 206, 758, 757, 0, 755, 0, 0, 0,
+	202, 752, 751, 0, 749, 0, 0, 0, 746, 0, 0, 0, 0, 0, 0, 0,
+	197, 742, 741, 0, 739, 0, 0, 0, 736, 0, 0, 0, 0, 0, 0, 0,
+	732, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	191, 727, 726, 0, 724, 0, 0, 0, 721, 0, 0, 0, 0, 0, 0, 0,
+	717, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	712, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	184, 706, 705, 0, 703, 0, 0, 0, 700, 0, 0, 0, 0, 0, 0, 0,
+	696, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	691, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	685, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	176, 678, 677, 0, 675, 0, 0, 0, 672, 0, 0, 0, 0, 0, 0, 0,
+	668, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	663, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	657, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	650, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	167, 642, 641, 0, 639, 0, 0, 0, 636, 0, 0, 0, 0, 0, 0, 0,
+	632, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	627, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	621, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	614, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	606, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	25, 157, 156, 597, 154, 596, 595, 0, 151, 593, 592, 0, 590, 0, 0, 0,
+	147, 587, 586, 0, 584, 0, 0, 0, 581, 0, 0, 0, 0, 0, 0, 0,
+	142, 577, 576, 0, 574, 0, 0, 0, 571, 0, 0, 0, 0, 0, 0, 0,
+	567, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	136, 562, 561, 0, 559, 0, 0, 0, 556, 0, 0, 0, 0, 0, 0, 0,
+	552, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	547, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	129, 541, 540, 0, 538, 0, 0, 0, 535, 0, 0, 0, 0, 0, 0, 0,
+	531, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	526, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	520, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	121, 513, 512, 0, 510, 0, 0, 0, 507, 0, 0, 0, 0, 0, 0, 0,
+	503, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	498, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	492, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	485, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	112, 477, 476, 0, 474, 0, 0, 0, 471, 0, 0, 0, 0, 0, 0, 0,
+	467, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	462, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	456, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	449, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	441, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	102, 432, 431, 0, 429, 0, 0, 0, 426, 0, 0, 0, 0, 0, 0, 0,
+	422, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	417, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	411, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	404, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	396, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	387, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+}
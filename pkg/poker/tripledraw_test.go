@@ -0,0 +1,85 @@
+package poker
+
+import (
+	"context"
+	"fmt"
+	mrand "math/rand"
+	"testing"
+)
+
+// countingStrategy counts the draws of a wrapped strategy
+type countingStrategy struct {
+	DrawStrategy
+	draws int
+}
+
+func (c *countingStrategy) Discard(r *mrand.Rand, hand []Card, goal DrawGoal, v DrawView) Discards {
+	c.draws++
+	return c.DrawStrategy.Discard(r, hand, goal, v)
+}
+
+func TestTripleDraw27Draws(t *testing.T) {
+	my := []Card{mustCard("2c"), mustCard("3d"), mustCard("4h"), mustCard("7s")}
+	info, _ := LookupGame("2-7 Triple Draw")
+	for _, strategy := range []DrawStrategy{DefaultDrawStrategy, MaxEVDraw{Samples: 20}} {
+		t.Run(fmt.Sprintf("%T", strategy), func(t *testing.T) {
+			// A full table runs the stub short in the later draws
+			players := info.MaxPlayers
+			s := &countingStrategy{DrawStrategy: strategy}
+			d := NewDeal(RemoveCards(FullDeck(), ToSet(my)), players)
+			TripleDraw27{Strategy: s}.CompleteHand(testRand(), my, d)
+
+			if s.draws != 3*players {
+				t.Errorf("strategy asked %d times, want 3 draws for each of %d players", s.draws, players)
+			}
+			// KeepBestN never breaks up a 7-4-3-2 draw, so the hero still
+			// holds it. MaxEVDraw decides from samples and can trade any card.
+			hero := ToSet(d.Hands[0])
+			if _, ok := strategy.(KeepBestN); ok && (!hero.Contains(my[0]) || !hero.Contains(my[1]) || !hero.Contains(my[2]) || !hero.Contains(my[3])) {
+				t.Errorf("hero ended with %v, want it to keep %v", d.Hands[0], my)
+			}
+			seen := ToSet(d.Deck).Union(ToSet(d.Muck))
+			for _, h := range d.Hands {
+				if seen.Intersect(ToSet(h)) != 0 {
+					t.Fatalf("card of %v dealt twice", h)
+				}
+				seen = seen.Union(ToSet(h))
+			}
+			if seen != AllCards {
+				t.Errorf("deal lost cards: %v", AllCards.Minus(seen))
+			}
+
+			res, err := Simulator{Seed: 1}.Equity(context.Background(), TripleDraw27{Strategy: strategy}, my, 200, players)
+			if err != nil || res.Iterations != 200 {
+				t.Errorf("Equity dealt %d deals with error %v, want 200", res.Iterations, err)
+			}
+		})
+	}
+}
+
+func TestSimulateTripleDraw27Equity(t *testing.T) {
+	tests := []struct {
+		name                 string
+		hand                 []Card
+		minEquity, maxEquity float64
+	}{
+		{
+			name:      "Smooth seven draw",
+			hand:      []Card{mustCard("2c"), mustCard("3d"), mustCard("4h"), mustCard("7s")},
+			minEquity: 0.7, maxEquity: 0.9,
+		},
+		{
+			name:      "Four broadway cards",
+			hand:      []Card{mustCard("Kc"), mustCard("Qd"), mustCard("Jh"), mustCard("Ts")},
+			minEquity: 0.25, maxEquity: 0.5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			equity := SimulateEquity(TripleDraw27{}, tt.hand, 2000, 2)
+			if equity < tt.minEquity || equity > tt.maxEquity {
+				t.Errorf("equity = %.3f, want in [%.2f, %.2f]", equity, tt.minEquity, tt.maxEquity)
+			}
+		})
+	}
+}